@@ -359,7 +359,7 @@ func (g *Gardener) Run(ctx context.Context, cancel context.CancelFunc) error {
 		}
 	)
 
-	go server.ServeHTTP(ctx, g.Config.Server.HTTP.Port, g.Config.Server.HTTP.BindAddress)
+	go server.ServeHTTP(ctx, nil, g.Config.Server.HTTP.Port, g.Config.Server.HTTP.BindAddress)
 	go server.ServeHTTPS(ctx, g.K8sGardenInformers, httpsHandlers, g.Config.Server.HTTPS.Port, g.Config.Server.HTTPS.BindAddress, g.Config.Server.HTTPS.TLS.ServerCertPath, g.Config.Server.HTTPS.TLS.ServerKeyPath, shootInformer.Informer(), projectInformer.Informer(), backupInfrastructureInformer.Informer())
 	handlers.UpdateHealth(true)
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
@@ -140,6 +141,7 @@ type GardenerScheduler struct {
 	Logger                 *logrus.Logger
 	Recorder               record.EventRecorder
 	LeaderElection         *leaderelection.LeaderElectionConfig
+	DecisionTrace          *shootcontroller.DecisionTrace
 }
 
 // NewGardenerScheduler is the main entry point of instantiating a new Gardener Scheduler.
@@ -206,6 +208,7 @@ func NewGardenerScheduler(cfg *config.SchedulerConfiguration) (*GardenerSchedule
 		K8sGardenClient:        k8sGardenClient,
 		K8sGardenCoreInformers: gardencoreinformers.NewSharedInformerFactory(k8sGardenClient.GardenCore(), 0),
 		LeaderElection:         leaderElectionConfig,
+		DecisionTrace:          shootcontroller.NewDecisionTrace(cfg.Schedulers.Shoot.DecisionTrace),
 	}, nil
 }
 
@@ -227,7 +230,11 @@ func (g *GardenerScheduler) Run(ctx context.Context) error {
 	}
 
 	// Start HTTP server (HTTPS not needed because no webhook server is needed at the moment)
-	go server.ServeHTTP(ctx, g.Config.Server.HTTP.Port, g.Config.Server.HTTP.BindAddress)
+	httpHandlers := map[string]func(http.ResponseWriter, *http.Request){}
+	if decisionTrace := g.Config.Schedulers.Shoot.DecisionTrace; decisionTrace != nil && decisionTrace.ServeEndpoint {
+		httpHandlers[shootcontroller.DecisionTracePath] = g.DecisionTrace.ServeHTTP
+	}
+	go server.ServeHTTP(ctx, httpHandlers, g.Config.Server.HTTP.Port, g.Config.Server.HTTP.BindAddress)
 	handlers.UpdateHealth(true)
 
 	// If leader election is enabled, run via LeaderElector until done and exit.
@@ -256,11 +263,12 @@ func (g *GardenerScheduler) Run(ctx context.Context) error {
 }
 
 func (g *GardenerScheduler) startScheduler(ctx context.Context) {
-	shootScheduler := shootcontroller.NewGardenerScheduler(g.K8sGardenClient, g.K8sGardenCoreInformers, g.Config, g.Recorder)
+	shootScheduler := shootcontroller.NewGardenerScheduler(g.K8sGardenClient, g.K8sGardenCoreInformers, g.Config, g.Recorder, g.DecisionTrace)
 	//backupBucketScheduler := backupbucketcontroller.NewGardenerScheduler(ctx, g.K8sGardenClient, g.K8sGardenCoreInformers, g.Config, g.Recorder)
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(shootScheduler) //, backupBucketScheduler)
+	shootcontroller.RegisterSchedulingMetrics()
 
 	go shootScheduler.Run(ctx, g.K8sGardenCoreInformers)
 	// TODO: Enable later
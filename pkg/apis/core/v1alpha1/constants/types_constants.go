@@ -97,6 +97,12 @@ const (
 	GardenerPurpose = "gardener.cloud/purpose"
 	// GardenPurposeMachineClass is a constant for the 'machineclass' value in a label.
 	GardenPurposeMachineClass = "machineclass"
+	// ShootPurposeEvaluation is a constant for the 'evaluation' value of the purpose annotation of a shoot.
+	ShootPurposeEvaluation = "evaluation"
+	// ShootPurposeDevelopment is a constant for the 'development' value of the purpose annotation of a shoot.
+	ShootPurposeDevelopment = "development"
+	// ShootPurposeProduction is a constant for the 'production' value of the purpose annotation of a shoot.
+	ShootPurposeProduction = "production"
 
 	// GardenerOperation is a constant for an annotation on a resource that describes a desired operation.
 	GardenerOperation = "gardener.cloud/operation"
@@ -121,6 +127,35 @@ const (
 	LabelSeedProvider = "seed.gardener.cloud/provider"
 	// LabelShootProvider is used to identify the shoot provider.
 	LabelShootProvider = "shoot.gardener.cloud/provider"
+	// AnnotationSeedCordoned is a constant for an annotation on a seed stating that no further shoots shall be
+	// scheduled to it, e.g. while it is being upgraded (if enabled in the gardener-scheduler configuration).
+	AnnotationSeedCordoned = "seed.gardener.cloud/cordoned"
+	// AnnotationSeedSchedulingPauseWindowBegin is a constant for an annotation on a seed stating the begin of a daily
+	// time window (in the format of the shoot maintenance time window, e.g. `220000+0100`) in which no further shoots
+	// shall be scheduled to it (if enabled in the gardener-scheduler configuration).
+	AnnotationSeedSchedulingPauseWindowBegin = "seed.gardener.cloud/scheduling-pause-window-begin"
+	// AnnotationSeedSchedulingPauseWindowEnd is a constant for an annotation on a seed stating the end of a daily
+	// time window in which no further shoots shall be scheduled to it.
+	AnnotationSeedSchedulingPauseWindowEnd = "seed.gardener.cloud/scheduling-pause-window-end"
+	// LabelSeedCapabilityPrefix is the prefix of labels a seed uses to advertise the capabilities it supports,
+	// e.g. `capability.seed.gardener.cloud/<name>=true`. The labels are maintained by the operators of the seeds.
+	LabelSeedCapabilityPrefix = "capability.seed.gardener.cloud/"
+	// AnnotationShootRequiredSeedCapabilities is a constant for an annotation on a shoot containing a comma-separated
+	// list of seed capabilities which are required by features enabled for the shoot. It is an opt-in contract between
+	// the owners of shoots and the operators of seeds (see LabelSeedCapabilityPrefix): no Gardener component sets it.
+	AnnotationShootRequiredSeedCapabilities = "shoot.gardener.cloud/required-seed-capabilities"
+	// AnnotationShootAcknowledgeDeprecatedMachineTypes is a constant for an annotation on a shoot acknowledging that its
+	// worker pools may select machine types which are marked as deprecated in the cloud profile.
+	AnnotationShootAcknowledgeDeprecatedMachineTypes = "shoot.gardener.cloud/acknowledge-deprecated-machine-types"
+	// SeedCapabilityInstanceFamilyPrefix is the prefix of capabilities a seed advertises (see LabelSeedCapabilityPrefix)
+	// for the instance families it supports, e.g. `capability.seed.gardener.cloud/instance-family.m5=true`.
+	SeedCapabilityInstanceFamilyPrefix = "instance-family."
+	// AnnotationShootSchedulingDecisions is a constant for an annotation on a shoot containing the JSON-encoded history
+	// of the scheduling decisions taken for it (if enabled in the gardener-scheduler configuration).
+	AnnotationShootSchedulingDecisions = "scheduler.gardener.cloud/scheduling-decisions"
+	// AnnotationShootPreviousSeed is a constant for an annotation on a shoot containing the name of the seed the shoot
+	// has been scheduled to most recently (if enabled in the gardener-scheduler configuration).
+	AnnotationShootPreviousSeed = "scheduler.gardener.cloud/previous-seed"
 	// LabelNetworkingProvider is used to identify the networking provider for the cni plugin.
 	LabelNetworkingProvider = "networking.shoot.gardener.cloud/provider"
 	// LabelExtensionConfiguration is used to identify the provider's configuration which will be added to Gardener configuration
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -183,3 +184,20 @@ func TaintsHave(taints []gardencorev1alpha1.SeedTaint, key string) bool {
 	}
 	return false
 }
+
+// MissingSeedCapabilities returns the seed capabilities which are required by the shoot with the given meta data
+// (see AnnotationShootRequiredSeedCapabilities) but not advertised by the seed with the given meta data (see
+// LabelSeedCapabilityPrefix).
+func MissingSeedCapabilities(shootMeta, seedMeta metav1.ObjectMeta) []string {
+	var missing []string
+	for _, capability := range strings.Split(shootMeta.Annotations[v1alpha1constants.AnnotationShootRequiredSeedCapabilities], ",") {
+		capability = strings.TrimSpace(capability)
+		if len(capability) == 0 {
+			continue
+		}
+		if seedMeta.Labels[v1alpha1constants.LabelSeedCapabilityPrefix+capability] != "true" {
+			missing = append(missing, capability)
+		}
+	}
+	return missing
+}
@@ -240,5 +240,22 @@ var _ = Describe("helper", func() {
 			Entry("taint exists", []gardencorev1alpha1.SeedTaint{{Key: "foo"}}, "foo", true),
 			Entry("taint does not exist", []gardencorev1alpha1.SeedTaint{{Key: "foo"}}, "bar", false),
 		)
+
+		DescribeTable("#MissingSeedCapabilities",
+			func(shootAnnotations, seedLabels map[string]string, expected []string) {
+				Expect(MissingSeedCapabilities(metav1.ObjectMeta{Annotations: shootAnnotations}, metav1.ObjectMeta{Labels: seedLabels})).To(Equal(expected))
+			},
+			Entry("no capabilities required", nil, nil, nil),
+			Entry("all capabilities advertised",
+				map[string]string{"shoot.gardener.cloud/required-seed-capabilities": "foo, bar"},
+				map[string]string{"capability.seed.gardener.cloud/foo": "true", "capability.seed.gardener.cloud/bar": "true"},
+				nil,
+			),
+			Entry("capabilities missing or disabled",
+				map[string]string{"shoot.gardener.cloud/required-seed-capabilities": "foo,bar,baz"},
+				map[string]string{"capability.seed.gardener.cloud/foo": "true", "capability.seed.gardener.cloud/bar": "false"},
+				[]string{"bar", "baz"},
+			),
+		)
 	})
 })
@@ -103,6 +103,9 @@ type ExpirableVersion struct {
 type MachineType struct {
 	// CPU is the number of CPUs for this machine type.
 	CPU resource.Quantity `json:"cpu"`
+	// Deprecated defines if the machine type is deprecated. Shoots may only select it if they acknowledge the deprecation.
+	// +optional
+	Deprecated *bool `json:"deprecated,omitempty"`
 	// GPU is the number of GPUs for this machine type.
 	GPU resource.Quantity `json:"gpu"`
 	// Memory is the amount of memory for this machine type.
@@ -60,6 +60,10 @@ type ShootSpec struct {
 	Addons *Addons `json:"addons,omitempty"`
 	// CloudProfileName is a name of a CloudProfile object.
 	CloudProfileName string `json:"cloudProfileName"`
+	// DataResidency is the jurisdiction (e.g. a country) the seed cluster running the control plane of the Shoot must be
+	// located in. If not set, the Shoot can be scheduled to seeds in any jurisdiction.
+	// +optional
+	DataResidency *string `json:"dataResidency,omitempty"`
 	// DNS contains information about the DNS settings of the Shoot.
 	// +optional
 	DNS *DNS `json:"dns,omitempty"`
@@ -290,6 +294,9 @@ type Kubernetes struct {
 
 // ClusterAutoscaler contains the configration flags for the Kubernetes cluster autoscaler.
 type ClusterAutoscaler struct {
+	// Expander defines the algorithm to use during scale up (default: least-waste).
+	// +optional
+	Expander *ExpanderMode `json:"expander,omitempty"`
 	// ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 10 mins).
 	// +optional
 	ScaleDownDelayAfterAdd *metav1.Duration `json:"scaleDownDelayAfterAdd,omitempty"`
@@ -310,6 +317,23 @@ type ClusterAutoscaler struct {
 	ScanInterval *metav1.Duration `json:"scanInterval,omitempty"`
 }
 
+// ExpanderMode is type used for Expander values
+type ExpanderMode string
+
+const (
+	// ClusterAutoscalerExpanderLeastWaste selects the node group that will have the least idle CPU (if tied, unused
+	// memory) after scale-up. This is the default expander.
+	ClusterAutoscalerExpanderLeastWaste ExpanderMode = "least-waste"
+	// ClusterAutoscalerExpanderMostPods selects the node group that would be able to schedule the most pods when scaling
+	// up.
+	ClusterAutoscalerExpanderMostPods ExpanderMode = "most-pods"
+	// ClusterAutoscalerExpanderPriority selects the node group that has the highest priority assigned by the user in
+	// the `cluster-autoscaler-priority-expander` config map in the `kube-system` namespace of the shoot.
+	ClusterAutoscalerExpanderPriority ExpanderMode = "priority"
+	// ClusterAutoscalerExpanderRandom selects a node group randomly.
+	ClusterAutoscalerExpanderRandom ExpanderMode = "random"
+)
+
 // KubernetesConfig contains common configuration fields for the control plane components.
 type KubernetesConfig struct {
 	// FeatureGates contains information about enabled feature gates.
@@ -848,4 +872,6 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventNoSeedsRegistered indicates that a scheduling decision failed because no seeds are registered at all.
+	ShootEventNoSeedsRegistered = "NoSeedsRegistered"
 )
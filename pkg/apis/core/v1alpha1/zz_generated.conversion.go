@@ -1705,6 +1705,7 @@ func autoConvert_garden_CloudProfileSpec_To_v1alpha1_CloudProfileSpec(in *garden
 }
 
 func autoConvert_v1alpha1_ClusterAutoscaler_To_garden_ClusterAutoscaler(in *ClusterAutoscaler, out *garden.ClusterAutoscaler, s conversion.Scope) error {
+	out.Expander = (*garden.ExpanderMode)(unsafe.Pointer(in.Expander))
 	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
 	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
 	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
@@ -1726,6 +1727,7 @@ func autoConvert_garden_ClusterAutoscaler_To_v1alpha1_ClusterAutoscaler(in *gard
 	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
 	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
 	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.Expander = (*ExpanderMode)(unsafe.Pointer(in.Expander))
 	return nil
 }
 
@@ -2811,6 +2813,7 @@ func Convert_garden_MachineImage_To_v1alpha1_MachineImage(in *garden.MachineImag
 
 func autoConvert_v1alpha1_MachineType_To_garden_MachineType(in *MachineType, out *garden.MachineType, s conversion.Scope) error {
 	out.CPU = in.CPU
+	out.Deprecated = (*bool)(unsafe.Pointer(in.Deprecated))
 	out.GPU = in.GPU
 	out.Memory = in.Memory
 	out.Name = in.Name
@@ -2827,6 +2830,7 @@ func Convert_v1alpha1_MachineType_To_garden_MachineType(in *MachineType, out *ga
 func autoConvert_garden_MachineType_To_v1alpha1_MachineType(in *garden.MachineType, out *MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
+	out.Deprecated = (*bool)(unsafe.Pointer(in.Deprecated))
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Storage = (*MachineTypeStorage)(unsafe.Pointer(in.Storage))
@@ -3927,6 +3931,7 @@ func autoConvert_v1alpha1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *gard
 		out.Addons = nil
 	}
 	out.CloudProfileName = in.CloudProfileName
+	out.DataResidency = (*string)(unsafe.Pointer(in.DataResidency))
 	out.DNS = (*garden.DNS)(unsafe.Pointer(in.DNS))
 	out.Extensions = *(*[]garden.Extension)(unsafe.Pointer(&in.Extensions))
 	out.Hibernation = (*garden.Hibernation)(unsafe.Pointer(in.Hibernation))
@@ -3966,6 +3971,7 @@ func autoConvert_garden_ShootSpec_To_v1alpha1_ShootSpec(in *garden.ShootSpec, ou
 	}
 	// WARNING: in.Cloud requires manual conversion: does not exist in peer-type
 	out.CloudProfileName = in.CloudProfileName
+	out.DataResidency = (*string)(unsafe.Pointer(in.DataResidency))
 	out.DNS = (*DNS)(unsafe.Pointer(in.DNS))
 	out.Extensions = *(*[]Extension)(unsafe.Pointer(&in.Extensions))
 	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
@@ -537,6 +537,11 @@ func (in *CloudProfileSpec) DeepCopy() *CloudProfileSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
+	if in.Expander != nil {
+		in, out := &in.Expander, &out.Expander
+		*out = new(ExpanderMode)
+		**out = **in
+	}
 	if in.ScaleDownDelayAfterAdd != nil {
 		in, out := &in.ScaleDownDelayAfterAdd, &out.ScaleDownDelayAfterAdd
 		*out = new(metav1.Duration)
@@ -1684,6 +1689,11 @@ func (in *MachineImage) DeepCopy() *MachineImage {
 func (in *MachineType) DeepCopyInto(out *MachineType) {
 	*out = *in
 	out.CPU = in.CPU.DeepCopy()
+	if in.Deprecated != nil {
+		in, out := &in.Deprecated, &out.Deprecated
+		*out = new(bool)
+		**out = **in
+	}
 	out.GPU = in.GPU.DeepCopy()
 	out.Memory = in.Memory.DeepCopy()
 	if in.Storage != nil {
@@ -2823,6 +2833,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(Addons)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DataResidency != nil {
+		in, out := &in.DataResidency, &out.DataResidency
+		*out = new(string)
+		**out = **in
+	}
 	if in.DNS != nil {
 		in, out := &in.DNS, &out.DNS
 		*out = new(DNS)
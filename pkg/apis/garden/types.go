@@ -354,6 +354,8 @@ type MachineType struct {
 	Name string
 	// Usable defines if the machine type can be used for shoot clusters.
 	Usable *bool
+	// Deprecated defines if the machine type is deprecated. Shoots may only select it if they acknowledge the deprecation.
+	Deprecated *bool
 	// CPU is the number of CPUs for this machine type.
 	CPU resource.Quantity
 	// GPU is the number of GPUs for this machine type.
@@ -792,6 +794,9 @@ type ShootSpec struct {
 	Cloud Cloud
 	// CloudProfileName is a name of a CloudProfile object.
 	CloudProfileName string
+	// DataResidency is the jurisdiction (e.g. a country) the seed cluster running the control plane of the Shoot must be
+	// located in. If not set, the Shoot can be scheduled to seeds in any jurisdiction.
+	DataResidency *string
 	// DNS contains information about the DNS settings of the Shoot.
 	DNS *DNS
 	// Extensions contain type and provider information for Shoot extensions.
@@ -1319,8 +1324,27 @@ type ClusterAutoscaler struct {
 	ScaleDownDelayAfterDelete *metav1.Duration
 	// ScanInterval how often cluster is reevaluated for scale up or down (default: 10 secs).
 	ScanInterval *metav1.Duration
+	// Expander defines the algorithm to use during scale up (default: least-waste).
+	Expander *ExpanderMode
 }
 
+// ExpanderMode is type used for Expander values
+type ExpanderMode string
+
+const (
+	// ClusterAutoscalerExpanderLeastWaste selects the node group that will have the least idle CPU (if tied, unused
+	// memory) after scale-up. This is the default expander.
+	ClusterAutoscalerExpanderLeastWaste ExpanderMode = "least-waste"
+	// ClusterAutoscalerExpanderMostPods selects the node group that would be able to schedule the most pods when scaling
+	// up.
+	ClusterAutoscalerExpanderMostPods ExpanderMode = "most-pods"
+	// ClusterAutoscalerExpanderPriority selects the node group that has the highest priority assigned by the user in
+	// the `cluster-autoscaler-priority-expander` config map in the `kube-system` namespace of the shoot.
+	ClusterAutoscalerExpanderPriority ExpanderMode = "priority"
+	// ClusterAutoscalerExpanderRandom selects a node group randomly.
+	ClusterAutoscalerExpanderRandom ExpanderMode = "random"
+)
+
 // KubernetesConfig contains common configuration fields for the control plane components.
 type KubernetesConfig struct {
 	// FeatureGates contains information about enabled feature gates.
@@ -331,6 +331,9 @@ type MachineType struct {
 	// Usable defines if the machine type can be used for shoot clusters.
 	// +optional
 	Usable *bool `json:"usable,omitempty"`
+	// Deprecated defines if the machine type is deprecated. Shoots may only select it if they acknowledge the deprecation.
+	// +optional
+	Deprecated *bool `json:"deprecated,omitempty"`
 	// CPU is the number of CPUs for this machine type.
 	CPU resource.Quantity `json:"cpu"`
 	// GPU is the number of GPUs for this machine type.
@@ -709,6 +712,10 @@ type ShootSpec struct {
 	Addons *Addons `json:"addons,omitempty"`
 	// Cloud contains information about the cloud environment and their specific settings.
 	Cloud Cloud `json:"cloud"`
+	// DataResidency is the jurisdiction (e.g. a country) the seed cluster running the control plane of the Shoot must be
+	// located in. If not set, the Shoot can be scheduled to seeds in any jurisdiction.
+	// +optional
+	DataResidency *string `json:"dataResidency,omitempty"`
 	// DNS contains information about the DNS settings of the Shoot.
 	DNS DNS `json:"dns"`
 	// Extensions contain type and provider information for Shoot extensions.
@@ -1372,8 +1379,28 @@ type ClusterAutoscaler struct {
 	// ScanInterval how often cluster is reevaluated for scale up or down (default: 10 secs).
 	// +optional
 	ScanInterval *metav1.Duration `json:"scanInterval,omitempty"`
+	// Expander defines the algorithm to use during scale up (default: least-waste).
+	// +optional
+	Expander *ExpanderMode `json:"expander,omitempty"`
 }
 
+// ExpanderMode is type used for Expander values
+type ExpanderMode string
+
+const (
+	// ClusterAutoscalerExpanderLeastWaste selects the node group that will have the least idle CPU (if tied, unused
+	// memory) after scale-up. This is the default expander.
+	ClusterAutoscalerExpanderLeastWaste ExpanderMode = "least-waste"
+	// ClusterAutoscalerExpanderMostPods selects the node group that would be able to schedule the most pods when scaling
+	// up.
+	ClusterAutoscalerExpanderMostPods ExpanderMode = "most-pods"
+	// ClusterAutoscalerExpanderPriority selects the node group that has the highest priority assigned by the user in
+	// the `cluster-autoscaler-priority-expander` config map in the `kube-system` namespace of the shoot.
+	ClusterAutoscalerExpanderPriority ExpanderMode = "priority"
+	// ClusterAutoscalerExpanderRandom selects a node group randomly.
+	ClusterAutoscalerExpanderRandom ExpanderMode = "random"
+)
+
 // KubernetesConfig contains common configuration fields for the control plane components.
 type KubernetesConfig struct {
 	// FeatureGates contains information about enabled feature gates.
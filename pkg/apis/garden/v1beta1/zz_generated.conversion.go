@@ -2694,6 +2694,7 @@ func autoConvert_v1beta1_ClusterAutoscaler_To_garden_ClusterAutoscaler(in *Clust
 	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
 	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
 	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.Expander = (*garden.ExpanderMode)(unsafe.Pointer(in.Expander))
 	return nil
 }
 
@@ -2709,6 +2710,7 @@ func autoConvert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *garde
 	out.ScaleDownDelayAfterFailure = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterFailure))
 	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
 	out.ScanInterval = (*metav1.Duration)(unsafe.Pointer(in.ScanInterval))
+	out.Expander = (*ExpanderMode)(unsafe.Pointer(in.Expander))
 	return nil
 }
 
@@ -3617,6 +3619,7 @@ func Convert_garden_MachineImageVersion_To_v1beta1_MachineImageVersion(in *garde
 func autoConvert_v1beta1_MachineType_To_garden_MachineType(in *MachineType, out *garden.MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
+	out.Deprecated = (*bool)(unsafe.Pointer(in.Deprecated))
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Storage = (*garden.MachineTypeStorage)(unsafe.Pointer(in.Storage))
@@ -3632,6 +3635,7 @@ func Convert_v1beta1_MachineType_To_garden_MachineType(in *MachineType, out *gar
 func autoConvert_garden_MachineType_To_v1beta1_MachineType(in *garden.MachineType, out *MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
+	out.Deprecated = (*bool)(unsafe.Pointer(in.Deprecated))
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Storage = (*MachineTypeStorage)(unsafe.Pointer(in.Storage))
@@ -4885,6 +4889,7 @@ func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garde
 	if err := Convert_v1beta1_Cloud_To_garden_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
+	out.DataResidency = (*string)(unsafe.Pointer(in.DataResidency))
 	// WARNING: in.DNS requires manual conversion: inconvertible types (github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS vs *github.com/gardener/gardener/pkg/apis/garden.DNS)
 	out.Extensions = *(*[]garden.Extension)(unsafe.Pointer(&in.Extensions))
 	out.Hibernation = (*garden.Hibernation)(unsafe.Pointer(in.Hibernation))
@@ -4902,6 +4907,7 @@ func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out
 		return err
 	}
 	// WARNING: in.CloudProfileName requires manual conversion: does not exist in peer-type
+	out.DataResidency = (*string)(unsafe.Pointer(in.DataResidency))
 	// WARNING: in.DNS requires manual conversion: inconvertible types (*github.com/gardener/gardener/pkg/apis/garden.DNS vs github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS)
 	out.Extensions = *(*[]Extension)(unsafe.Pointer(&in.Extensions))
 	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
@@ -1099,6 +1099,11 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Expander != nil {
+		in, out := &in.Expander, &out.Expander
+		*out = new(ExpanderMode)
+		**out = **in
+	}
 	return
 }
 
@@ -2140,6 +2145,11 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Deprecated != nil {
+		in, out := &in.Deprecated, &out.Deprecated
+		*out = new(bool)
+		**out = **in
+	}
 	out.CPU = in.CPU.DeepCopy()
 	out.GPU = in.GPU.DeepCopy()
 	if in.Storage != nil {
@@ -3394,6 +3404,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Cloud.DeepCopyInto(&out.Cloud)
+	if in.DataResidency != nil {
+		in, out := &in.DataResidency, &out.DataResidency
+		*out = new(string)
+		**out = **in
+	}
 	in.DNS.DeepCopyInto(&out.DNS)
 	if in.Extensions != nil {
 		in, out := &in.Extensions, &out.Extensions
@@ -1285,6 +1285,13 @@ func schema_pkg_apis_core_v1alpha1_ClusterAutoscaler(ref common.ReferenceCallbac
 				Description: "ClusterAutoscaler contains the configration flags for the Kubernetes cluster autoscaler.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"expander": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expander defines the algorithm to use during scale up (default: least-waste).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"scaleDownDelayAfterAdd": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 10 mins).",
@@ -2930,6 +2937,13 @@ func schema_pkg_apis_core_v1alpha1_MachineType(ref common.ReferenceCallback) com
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"deprecated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated defines if the machine type is deprecated. Shoots may only select it if they acknowledge the deprecation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"gpu": {
 						SchemaProps: spec.SchemaProps{
 							Description: "GPU is the number of GPUs for this machine type.",
@@ -4677,6 +4691,13 @@ func schema_pkg_apis_core_v1alpha1_ShootSpec(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"dataResidency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataResidency is the jurisdiction (e.g. a country) the seed cluster running the control plane of the Shoot must be located in. If not set, the Shoot can be scheduled to seeds in any jurisdiction.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"dns": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DNS contains information about the DNS settings of the Shoot.",
@@ -5764,6 +5785,13 @@ func schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"deprecated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated defines if the machine type is deprecated. Shoots may only select it if they acknowledge the deprecation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"cpu": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CPU is the number of CPUs for this machine type.",
@@ -7063,6 +7091,13 @@ func schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref common.ReferenceCallba
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"expander": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expander defines the algorithm to use during scale up (default: least-waste).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -8669,6 +8704,13 @@ func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"deprecated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated defines if the machine type is deprecated. Shoots may only select it if they acknowledge the deprecation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"cpu": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CPU is the number of CPUs for this machine type.",
@@ -9356,6 +9398,13 @@ func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"deprecated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated defines if the machine type is deprecated. Shoots may only select it if they acknowledge the deprecation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"cpu": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CPU is the number of CPUs for this machine type.",
@@ -10916,6 +10965,13 @@ func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
 						},
 					},
+					"dataResidency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataResidency is the jurisdiction (e.g. a country) the seed cluster running the control plane of the Shoot must be located in. If not set, the Shoot can be scheduled to seeds in any jurisdiction.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"dns": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DNS contains information about the DNS settings of the Shoot.",
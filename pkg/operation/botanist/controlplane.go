@@ -207,6 +207,9 @@ func (b *Botanist) DeployClusterAutoscaler(ctx context.Context) error {
 		if val := clusterAutoscalerConfig.ScanInterval; val != nil {
 			defaultValues["scanInterval"] = *val
 		}
+		if val := clusterAutoscalerConfig.Expander; val != nil {
+			defaultValues["expander"] = *val
+		}
 	}
 
 	values, err := b.InjectSeedShootImages(defaultValues, common.ClusterAutoscalerImageName)
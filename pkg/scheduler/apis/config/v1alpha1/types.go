@@ -43,6 +43,19 @@ const (
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
 var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
 
+const (
+	// BalanceByShootCount Strategy chooses the seed candidate managing the smallest number of shoots.
+	BalanceByShootCount BalancingStrategy = "ShootCount"
+	// BalanceByNodeCount Strategy chooses the seed candidate whose shoots sum up to the smallest maximum number of worker nodes.
+	BalanceByNodeCount BalancingStrategy = "NodeCount"
+)
+
+// BalancingStrategies defines all currently implemented BalancingStrategies
+var BalancingStrategies = []BalancingStrategy{BalanceByShootCount, BalanceByNodeCount}
+
+// BalancingStrategy defines how the usage of the seed candidates is compared to choose the least used one
+type BalancingStrategy string
+
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
@@ -100,6 +113,172 @@ type ShootSchedulerConfiguration struct {
 	RetrySyncPeriod metav1.Duration `json:"retrySyncPeriod,omitempty"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// SeedFlapDetection defines how seeds that repeatedly change their availability are excluded from scheduling.
+	// If not set, the availability history of seeds is not considered. The history is only kept in memory, i.e. it
+	// starts empty whenever the scheduler is restarted or another instance becomes the leader.
+	// +optional
+	SeedFlapDetection *SeedFlapDetectionConfiguration `json:"seedFlapDetection,omitempty"`
+	// BlockedSeeds is a list of names of seeds that must not be considered when scheduling shoots, e.g. while an
+	// incident on these seeds is being handled.
+	// +optional
+	BlockedSeeds []string `json:"blockedSeeds,omitempty"`
+	// HonorSeedCordoning defines whether seeds annotated with `seed.gardener.cloud/cordoned=true` (e.g. while they are
+	// being upgraded) are excluded from scheduling.
+	// +optional
+	HonorSeedCordoning bool `json:"honorSeedCordoning,omitempty"`
+	// HonorSeedSchedulingPauseWindows defines whether seeds are excluded from scheduling while they are within the
+	// daily pause window stated in their `seed.gardener.cloud/scheduling-pause-window-begin` and
+	// `seed.gardener.cloud/scheduling-pause-window-end` annotations (e.g. during their own maintenance).
+	// +optional
+	HonorSeedSchedulingPauseWindows bool `json:"honorSeedSchedulingPauseWindows,omitempty"`
+	// SameOrganizationPreference defines how shoots prefer seeds that are owned by the same organization. Seeds of the
+	// same organization are preferred over other equally used seeds but are not required.
+	// +optional
+	SameOrganizationPreference *SameOrganizationPreferenceConfiguration `json:"sameOrganizationPreference,omitempty"`
+	// ProductionSeedPreference defines how seeds advertise that they are production-grade. Shoots with the purpose
+	// `production` prefer such seeds over other equally used seeds but do not require them.
+	// +optional
+	ProductionSeedPreference *ProductionSeedPreferenceConfiguration `json:"productionSeedPreference,omitempty"`
+	// LifetimePacking defines how seeds are designated for short-lived shoots, i.e. shoots with the purpose `evaluation`
+	// or an expiration timestamp. Short-lived shoots prefer the designated seeds while all other shoots prefer the
+	// remaining seeds, so that short-lived shoots are concentrated on a few seeds and the others are kept free for
+	// long-lived (e.g. production) shoots. The preferred seeds are chosen regardless of the usage of the other seeds.
+	// +optional
+	LifetimePacking *LifetimePackingConfiguration `json:"lifetimePacking,omitempty"`
+	// PreferSeedInstanceFamilies defines whether shoots prefer seeds advertising the instance families of the machine
+	// types of all their worker pools (with a `capability.seed.gardener.cloud/instance-family.<family>=true` label) over
+	// other equally used seeds, e.g. because only these seeds have spare capacity in the instance families.
+	// +optional
+	PreferSeedInstanceFamilies bool `json:"preferSeedInstanceFamilies,omitempty"`
+	// RateLimit defines how many shoots may be scheduled per second. Shoots exceeding the limit remain queued until
+	// they can be scheduled. If not set, the scheduling throughput is not limited.
+	// +optional
+	RateLimit *SchedulingRateLimitConfiguration `json:"rateLimit,omitempty"`
+	// FairQueuing defines whether the queued shoots are scheduled alternately per project (i.e. namespace) instead of in
+	// the order they were queued, so that a project creating many shoots at once does not delay the shoots of other
+	// projects.
+	// +optional
+	FairQueuing bool `json:"fairQueuing,omitempty"`
+	// ComplianceTier defines how shoots request a compliance tier. Such shoots are only scheduled to seeds having an
+	// equal or higher compliance tier.
+	// +optional
+	ComplianceTier *ComplianceTierConfiguration `json:"complianceTier,omitempty"`
+	// DataResidency defines how seeds state the jurisdiction they are located in. Shoots requiring a jurisdiction in
+	// their data residency are only scheduled to seeds in it. If not set, such shoots cannot be scheduled.
+	// +optional
+	DataResidency *DataResidencyConfiguration `json:"dataResidency,omitempty"`
+	// DecisionAudit defines whether the scheduling decisions are recorded in the history annotation of the shoots. If
+	// not set, the scheduling decisions are not recorded.
+	// +optional
+	DecisionAudit *SchedulingDecisionAuditConfiguration `json:"decisionAudit,omitempty"`
+	// DecisionTrace defines whether the most recent scheduling decisions are kept in memory. If not set, the scheduling
+	// decisions are not traced.
+	// +optional
+	DecisionTrace *SchedulingDecisionTraceConfiguration `json:"decisionTrace,omitempty"`
+	// PreferPreviousSeed defines whether shoots are preferably scheduled to the seed they were previously scheduled to
+	// (as stated in their `scheduler.gardener.cloud/previous-seed` annotation) if it is still a suitable candidate and
+	// not more used than other candidates.
+	// +optional
+	PreferPreviousSeed bool `json:"preferPreviousSeed,omitempty"`
+	// BalancingStrategy defines how the least used seed is chosen among the remaining candidates. Defaults to
+	// `ShootCount` if not set.
+	// +optional
+	BalancingStrategy BalancingStrategy `json:"balancingStrategy,omitempty"`
+	// RegistryProximity defines how seeds advertise their proximity to the container registry. Among equally used
+	// candidates, the seed with the highest proximity is preferred as it pulls images faster. If not set, the proximity
+	// is not considered.
+	// +optional
+	RegistryProximity *RegistryProximityConfiguration `json:"registryProximity,omitempty"`
+	// SpreadProjectShootsAcrossRegions defines whether, among equally used candidates, seeds in regions hosting fewer
+	// shoots of the same project are preferred, so that the shoots of a project are spread across regions.
+	// +optional
+	SpreadProjectShootsAcrossRegions bool `json:"spreadProjectShootsAcrossRegions,omitempty"`
+	// LogCandidateScores defines whether the scores every candidate received in the last scheduling step (its usage,
+	// region distance and the values of the configured tie-breakers) are logged. They are logged at debug level only.
+	// +optional
+	LogCandidateScores bool `json:"logCandidateScores,omitempty"`
+}
+
+// RegistryProximityConfiguration defines the configuration for preferring seeds close to the container registry.
+// Proximity scores are non-negative integers, higher scores indicate a closer registry.
+type RegistryProximityConfiguration struct {
+	// SeedLabel is the key of the seed label that contains the registry proximity score of the seed.
+	SeedLabel string `json:"seedLabel"`
+}
+
+// SchedulingDecisionAuditConfiguration defines the configuration for recording the scheduling decisions.
+type SchedulingDecisionAuditConfiguration struct {
+	// MaxRecords is the maximum number of scheduling decisions kept in the history of a shoot. Older decisions are
+	// removed first.
+	MaxRecords int `json:"maxRecords"`
+}
+
+// SchedulingDecisionTraceConfiguration defines the configuration for tracing the most recent scheduling decisions.
+type SchedulingDecisionTraceConfiguration struct {
+	// MaxRecords is the maximum number of scheduling decisions kept in the trace. Older decisions are removed first.
+	MaxRecords int `json:"maxRecords"`
+	// ServeEndpoint defines whether the traced decisions are served on the `/debug/scheduling-decisions` endpoint of
+	// the HTTP server. As this server is not authenticated and the decisions contain the names of shoots, they are not
+	// served by default.
+	// +optional
+	ServeEndpoint bool `json:"serveEndpoint,omitempty"`
+}
+
+// ComplianceTierConfiguration defines the configuration for scheduling shoots to seeds of a sufficient compliance tier.
+// Compliance tiers are non-negative integers, higher tiers satisfy stricter compliance requirements.
+type ComplianceTierConfiguration struct {
+	// SeedLabel is the key of the seed label that contains the compliance tier of the seed.
+	SeedLabel string `json:"seedLabel"`
+	// ShootAnnotation is the key of the shoot annotation that contains the compliance tier required by the shoot.
+	ShootAnnotation string `json:"shootAnnotation"`
+}
+
+// DataResidencyConfiguration defines the configuration for scheduling shoots to seeds in the jurisdiction (e.g. a
+// country) required by their data residency.
+type DataResidencyConfiguration struct {
+	// SeedLabel is the key of the seed label that contains the jurisdiction the seed is located in.
+	SeedLabel string `json:"seedLabel"`
+}
+
+// SchedulingRateLimitConfiguration defines the token bucket used to limit the scheduling throughput.
+type SchedulingRateLimitConfiguration struct {
+	// QPS is the number of shoots which may be scheduled per second on average.
+	QPS float32 `json:"qps"`
+	// Burst is the maximum number of shoots which may be scheduled at once.
+	Burst int `json:"burst"`
+}
+
+// SameOrganizationPreferenceConfiguration defines the configuration for preferring seeds of the same organization.
+type SameOrganizationPreferenceConfiguration struct {
+	// SeedLabel is the key of the seed label that contains the organization owning the seed.
+	SeedLabel string `json:"seedLabel"`
+	// ShootAnnotation is the key of the shoot annotation that contains the organization whose seeds are preferred.
+	ShootAnnotation string `json:"shootAnnotation"`
+}
+
+// ProductionSeedPreferenceConfiguration defines the configuration for preferring production-grade seeds for shoots
+// with the purpose `production`.
+type ProductionSeedPreferenceConfiguration struct {
+	// SeedLabel is the key of the seed label that contains the tier of the seed.
+	SeedLabel string `json:"seedLabel"`
+	// ProductionTier is the value of the seed label identifying production-grade seeds.
+	ProductionTier string `json:"productionTier"`
+}
+
+// LifetimePackingConfiguration defines the configuration for concentrating short-lived shoots on designated seeds.
+type LifetimePackingConfiguration struct {
+	// SeedLabel is the key of the seed label designating seeds for short-lived shoots (if its value is `true`).
+	SeedLabel string `json:"seedLabel"`
+}
+
+// SeedFlapDetectionConfiguration defines the configuration for detecting seeds whose `SeedAvailable` condition
+// is flapping.
+type SeedFlapDetectionConfiguration struct {
+	// MaxTransitions is the maximum number of transitions of the `SeedAvailable` condition that a seed may have
+	// within the window before it is excluded from scheduling.
+	MaxTransitions int `json:"maxTransitions"`
+	// Window is the duration in which transitions of the `SeedAvailable` condition are counted.
+	Window metav1.Duration `json:"window"`
 }
 
 // DiscoveryConfiguration defines the configuration of how to discover API groups.
@@ -47,6 +47,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComplianceTierConfiguration)(nil), (*config.ComplianceTierConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComplianceTierConfiguration_To_config_ComplianceTierConfiguration(a.(*ComplianceTierConfiguration), b.(*config.ComplianceTierConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComplianceTierConfiguration)(nil), (*ComplianceTierConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComplianceTierConfiguration_To_v1alpha1_ComplianceTierConfiguration(a.(*config.ComplianceTierConfiguration), b.(*ComplianceTierConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DataResidencyConfiguration)(nil), (*config.DataResidencyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataResidencyConfiguration_To_config_DataResidencyConfiguration(a.(*DataResidencyConfiguration), b.(*config.DataResidencyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DataResidencyConfiguration)(nil), (*DataResidencyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DataResidencyConfiguration_To_v1alpha1_DataResidencyConfiguration(a.(*config.DataResidencyConfiguration), b.(*DataResidencyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DiscoveryConfiguration)(nil), (*config.DiscoveryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DiscoveryConfiguration_To_config_DiscoveryConfiguration(a.(*DiscoveryConfiguration), b.(*config.DiscoveryConfiguration), scope)
 	}); err != nil {
@@ -67,6 +87,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LifetimePackingConfiguration)(nil), (*config.LifetimePackingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifetimePackingConfiguration_To_config_LifetimePackingConfiguration(a.(*LifetimePackingConfiguration), b.(*config.LifetimePackingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LifetimePackingConfiguration)(nil), (*LifetimePackingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LifetimePackingConfiguration_To_v1alpha1_LifetimePackingConfiguration(a.(*config.LifetimePackingConfiguration), b.(*LifetimePackingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProductionSeedPreferenceConfiguration)(nil), (*config.ProductionSeedPreferenceConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProductionSeedPreferenceConfiguration_To_config_ProductionSeedPreferenceConfiguration(a.(*ProductionSeedPreferenceConfiguration), b.(*config.ProductionSeedPreferenceConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProductionSeedPreferenceConfiguration)(nil), (*ProductionSeedPreferenceConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProductionSeedPreferenceConfiguration_To_v1alpha1_ProductionSeedPreferenceConfiguration(a.(*config.ProductionSeedPreferenceConfiguration), b.(*ProductionSeedPreferenceConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryProximityConfiguration)(nil), (*config.RegistryProximityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegistryProximityConfiguration_To_config_RegistryProximityConfiguration(a.(*RegistryProximityConfiguration), b.(*config.RegistryProximityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RegistryProximityConfiguration)(nil), (*RegistryProximityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RegistryProximityConfiguration_To_v1alpha1_RegistryProximityConfiguration(a.(*config.RegistryProximityConfiguration), b.(*RegistryProximityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SameOrganizationPreferenceConfiguration)(nil), (*config.SameOrganizationPreferenceConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SameOrganizationPreferenceConfiguration_To_config_SameOrganizationPreferenceConfiguration(a.(*SameOrganizationPreferenceConfiguration), b.(*config.SameOrganizationPreferenceConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SameOrganizationPreferenceConfiguration)(nil), (*SameOrganizationPreferenceConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SameOrganizationPreferenceConfiguration_To_v1alpha1_SameOrganizationPreferenceConfiguration(a.(*config.SameOrganizationPreferenceConfiguration), b.(*SameOrganizationPreferenceConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SchedulerConfiguration)(nil), (*config.SchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(a.(*SchedulerConfiguration), b.(*config.SchedulerConfiguration), scope)
 	}); err != nil {
@@ -87,6 +147,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingDecisionAuditConfiguration)(nil), (*config.SchedulingDecisionAuditConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchedulingDecisionAuditConfiguration_To_config_SchedulingDecisionAuditConfiguration(a.(*SchedulingDecisionAuditConfiguration), b.(*config.SchedulingDecisionAuditConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SchedulingDecisionAuditConfiguration)(nil), (*SchedulingDecisionAuditConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SchedulingDecisionAuditConfiguration_To_v1alpha1_SchedulingDecisionAuditConfiguration(a.(*config.SchedulingDecisionAuditConfiguration), b.(*SchedulingDecisionAuditConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingDecisionTraceConfiguration)(nil), (*config.SchedulingDecisionTraceConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchedulingDecisionTraceConfiguration_To_config_SchedulingDecisionTraceConfiguration(a.(*SchedulingDecisionTraceConfiguration), b.(*config.SchedulingDecisionTraceConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SchedulingDecisionTraceConfiguration)(nil), (*SchedulingDecisionTraceConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SchedulingDecisionTraceConfiguration_To_v1alpha1_SchedulingDecisionTraceConfiguration(a.(*config.SchedulingDecisionTraceConfiguration), b.(*SchedulingDecisionTraceConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingRateLimitConfiguration)(nil), (*config.SchedulingRateLimitConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchedulingRateLimitConfiguration_To_config_SchedulingRateLimitConfiguration(a.(*SchedulingRateLimitConfiguration), b.(*config.SchedulingRateLimitConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SchedulingRateLimitConfiguration)(nil), (*SchedulingRateLimitConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SchedulingRateLimitConfiguration_To_v1alpha1_SchedulingRateLimitConfiguration(a.(*config.SchedulingRateLimitConfiguration), b.(*SchedulingRateLimitConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedFlapDetectionConfiguration)(nil), (*config.SeedFlapDetectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedFlapDetectionConfiguration_To_config_SeedFlapDetectionConfiguration(a.(*SeedFlapDetectionConfiguration), b.(*config.SeedFlapDetectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedFlapDetectionConfiguration)(nil), (*SeedFlapDetectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedFlapDetectionConfiguration_To_v1alpha1_SeedFlapDetectionConfiguration(a.(*config.SeedFlapDetectionConfiguration), b.(*SeedFlapDetectionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -142,6 +242,48 @@ func Convert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketS
 	return autoConvert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketSchedulerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComplianceTierConfiguration_To_config_ComplianceTierConfiguration(in *ComplianceTierConfiguration, out *config.ComplianceTierConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	out.ShootAnnotation = in.ShootAnnotation
+	return nil
+}
+
+// Convert_v1alpha1_ComplianceTierConfiguration_To_config_ComplianceTierConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ComplianceTierConfiguration_To_config_ComplianceTierConfiguration(in *ComplianceTierConfiguration, out *config.ComplianceTierConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComplianceTierConfiguration_To_config_ComplianceTierConfiguration(in, out, s)
+}
+
+func autoConvert_config_ComplianceTierConfiguration_To_v1alpha1_ComplianceTierConfiguration(in *config.ComplianceTierConfiguration, out *ComplianceTierConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	out.ShootAnnotation = in.ShootAnnotation
+	return nil
+}
+
+// Convert_config_ComplianceTierConfiguration_To_v1alpha1_ComplianceTierConfiguration is an autogenerated conversion function.
+func Convert_config_ComplianceTierConfiguration_To_v1alpha1_ComplianceTierConfiguration(in *config.ComplianceTierConfiguration, out *ComplianceTierConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ComplianceTierConfiguration_To_v1alpha1_ComplianceTierConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_DataResidencyConfiguration_To_config_DataResidencyConfiguration(in *DataResidencyConfiguration, out *config.DataResidencyConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	return nil
+}
+
+// Convert_v1alpha1_DataResidencyConfiguration_To_config_DataResidencyConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DataResidencyConfiguration_To_config_DataResidencyConfiguration(in *DataResidencyConfiguration, out *config.DataResidencyConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataResidencyConfiguration_To_config_DataResidencyConfiguration(in, out, s)
+}
+
+func autoConvert_config_DataResidencyConfiguration_To_v1alpha1_DataResidencyConfiguration(in *config.DataResidencyConfiguration, out *DataResidencyConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	return nil
+}
+
+// Convert_config_DataResidencyConfiguration_To_v1alpha1_DataResidencyConfiguration is an autogenerated conversion function.
+func Convert_config_DataResidencyConfiguration_To_v1alpha1_DataResidencyConfiguration(in *config.DataResidencyConfiguration, out *DataResidencyConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DataResidencyConfiguration_To_v1alpha1_DataResidencyConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_DiscoveryConfiguration_To_config_DiscoveryConfiguration(in *DiscoveryConfiguration, out *config.DiscoveryConfiguration, s conversion.Scope) error {
 	out.DiscoveryCacheDir = (*string)(unsafe.Pointer(in.DiscoveryCacheDir))
 	out.HTTPCacheDir = (*string)(unsafe.Pointer(in.HTTPCacheDir))
@@ -194,6 +336,90 @@ func Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfig
 	return autoConvert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_LifetimePackingConfiguration_To_config_LifetimePackingConfiguration(in *LifetimePackingConfiguration, out *config.LifetimePackingConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	return nil
+}
+
+// Convert_v1alpha1_LifetimePackingConfiguration_To_config_LifetimePackingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_LifetimePackingConfiguration_To_config_LifetimePackingConfiguration(in *LifetimePackingConfiguration, out *config.LifetimePackingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifetimePackingConfiguration_To_config_LifetimePackingConfiguration(in, out, s)
+}
+
+func autoConvert_config_LifetimePackingConfiguration_To_v1alpha1_LifetimePackingConfiguration(in *config.LifetimePackingConfiguration, out *LifetimePackingConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	return nil
+}
+
+// Convert_config_LifetimePackingConfiguration_To_v1alpha1_LifetimePackingConfiguration is an autogenerated conversion function.
+func Convert_config_LifetimePackingConfiguration_To_v1alpha1_LifetimePackingConfiguration(in *config.LifetimePackingConfiguration, out *LifetimePackingConfiguration, s conversion.Scope) error {
+	return autoConvert_config_LifetimePackingConfiguration_To_v1alpha1_LifetimePackingConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProductionSeedPreferenceConfiguration_To_config_ProductionSeedPreferenceConfiguration(in *ProductionSeedPreferenceConfiguration, out *config.ProductionSeedPreferenceConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	out.ProductionTier = in.ProductionTier
+	return nil
+}
+
+// Convert_v1alpha1_ProductionSeedPreferenceConfiguration_To_config_ProductionSeedPreferenceConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ProductionSeedPreferenceConfiguration_To_config_ProductionSeedPreferenceConfiguration(in *ProductionSeedPreferenceConfiguration, out *config.ProductionSeedPreferenceConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProductionSeedPreferenceConfiguration_To_config_ProductionSeedPreferenceConfiguration(in, out, s)
+}
+
+func autoConvert_config_ProductionSeedPreferenceConfiguration_To_v1alpha1_ProductionSeedPreferenceConfiguration(in *config.ProductionSeedPreferenceConfiguration, out *ProductionSeedPreferenceConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	out.ProductionTier = in.ProductionTier
+	return nil
+}
+
+// Convert_config_ProductionSeedPreferenceConfiguration_To_v1alpha1_ProductionSeedPreferenceConfiguration is an autogenerated conversion function.
+func Convert_config_ProductionSeedPreferenceConfiguration_To_v1alpha1_ProductionSeedPreferenceConfiguration(in *config.ProductionSeedPreferenceConfiguration, out *ProductionSeedPreferenceConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ProductionSeedPreferenceConfiguration_To_v1alpha1_ProductionSeedPreferenceConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_RegistryProximityConfiguration_To_config_RegistryProximityConfiguration(in *RegistryProximityConfiguration, out *config.RegistryProximityConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	return nil
+}
+
+// Convert_v1alpha1_RegistryProximityConfiguration_To_config_RegistryProximityConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_RegistryProximityConfiguration_To_config_RegistryProximityConfiguration(in *RegistryProximityConfiguration, out *config.RegistryProximityConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegistryProximityConfiguration_To_config_RegistryProximityConfiguration(in, out, s)
+}
+
+func autoConvert_config_RegistryProximityConfiguration_To_v1alpha1_RegistryProximityConfiguration(in *config.RegistryProximityConfiguration, out *RegistryProximityConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	return nil
+}
+
+// Convert_config_RegistryProximityConfiguration_To_v1alpha1_RegistryProximityConfiguration is an autogenerated conversion function.
+func Convert_config_RegistryProximityConfiguration_To_v1alpha1_RegistryProximityConfiguration(in *config.RegistryProximityConfiguration, out *RegistryProximityConfiguration, s conversion.Scope) error {
+	return autoConvert_config_RegistryProximityConfiguration_To_v1alpha1_RegistryProximityConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SameOrganizationPreferenceConfiguration_To_config_SameOrganizationPreferenceConfiguration(in *SameOrganizationPreferenceConfiguration, out *config.SameOrganizationPreferenceConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	out.ShootAnnotation = in.ShootAnnotation
+	return nil
+}
+
+// Convert_v1alpha1_SameOrganizationPreferenceConfiguration_To_config_SameOrganizationPreferenceConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SameOrganizationPreferenceConfiguration_To_config_SameOrganizationPreferenceConfiguration(in *SameOrganizationPreferenceConfiguration, out *config.SameOrganizationPreferenceConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SameOrganizationPreferenceConfiguration_To_config_SameOrganizationPreferenceConfiguration(in, out, s)
+}
+
+func autoConvert_config_SameOrganizationPreferenceConfiguration_To_v1alpha1_SameOrganizationPreferenceConfiguration(in *config.SameOrganizationPreferenceConfiguration, out *SameOrganizationPreferenceConfiguration, s conversion.Scope) error {
+	out.SeedLabel = in.SeedLabel
+	out.ShootAnnotation = in.ShootAnnotation
+	return nil
+}
+
+// Convert_config_SameOrganizationPreferenceConfiguration_To_v1alpha1_SameOrganizationPreferenceConfiguration is an autogenerated conversion function.
+func Convert_config_SameOrganizationPreferenceConfiguration_To_v1alpha1_SameOrganizationPreferenceConfiguration(in *config.SameOrganizationPreferenceConfiguration, out *SameOrganizationPreferenceConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SameOrganizationPreferenceConfiguration_To_v1alpha1_SameOrganizationPreferenceConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(in *SchedulerConfiguration, out *config.SchedulerConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -266,6 +492,92 @@ func Convert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerContro
 	return autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SchedulingDecisionAuditConfiguration_To_config_SchedulingDecisionAuditConfiguration(in *SchedulingDecisionAuditConfiguration, out *config.SchedulingDecisionAuditConfiguration, s conversion.Scope) error {
+	out.MaxRecords = in.MaxRecords
+	return nil
+}
+
+// Convert_v1alpha1_SchedulingDecisionAuditConfiguration_To_config_SchedulingDecisionAuditConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SchedulingDecisionAuditConfiguration_To_config_SchedulingDecisionAuditConfiguration(in *SchedulingDecisionAuditConfiguration, out *config.SchedulingDecisionAuditConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchedulingDecisionAuditConfiguration_To_config_SchedulingDecisionAuditConfiguration(in, out, s)
+}
+
+func autoConvert_config_SchedulingDecisionAuditConfiguration_To_v1alpha1_SchedulingDecisionAuditConfiguration(in *config.SchedulingDecisionAuditConfiguration, out *SchedulingDecisionAuditConfiguration, s conversion.Scope) error {
+	out.MaxRecords = in.MaxRecords
+	return nil
+}
+
+// Convert_config_SchedulingDecisionAuditConfiguration_To_v1alpha1_SchedulingDecisionAuditConfiguration is an autogenerated conversion function.
+func Convert_config_SchedulingDecisionAuditConfiguration_To_v1alpha1_SchedulingDecisionAuditConfiguration(in *config.SchedulingDecisionAuditConfiguration, out *SchedulingDecisionAuditConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SchedulingDecisionAuditConfiguration_To_v1alpha1_SchedulingDecisionAuditConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SchedulingDecisionTraceConfiguration_To_config_SchedulingDecisionTraceConfiguration(in *SchedulingDecisionTraceConfiguration, out *config.SchedulingDecisionTraceConfiguration, s conversion.Scope) error {
+	out.MaxRecords = in.MaxRecords
+	out.ServeEndpoint = in.ServeEndpoint
+	return nil
+}
+
+// Convert_v1alpha1_SchedulingDecisionTraceConfiguration_To_config_SchedulingDecisionTraceConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SchedulingDecisionTraceConfiguration_To_config_SchedulingDecisionTraceConfiguration(in *SchedulingDecisionTraceConfiguration, out *config.SchedulingDecisionTraceConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchedulingDecisionTraceConfiguration_To_config_SchedulingDecisionTraceConfiguration(in, out, s)
+}
+
+func autoConvert_config_SchedulingDecisionTraceConfiguration_To_v1alpha1_SchedulingDecisionTraceConfiguration(in *config.SchedulingDecisionTraceConfiguration, out *SchedulingDecisionTraceConfiguration, s conversion.Scope) error {
+	out.MaxRecords = in.MaxRecords
+	out.ServeEndpoint = in.ServeEndpoint
+	return nil
+}
+
+// Convert_config_SchedulingDecisionTraceConfiguration_To_v1alpha1_SchedulingDecisionTraceConfiguration is an autogenerated conversion function.
+func Convert_config_SchedulingDecisionTraceConfiguration_To_v1alpha1_SchedulingDecisionTraceConfiguration(in *config.SchedulingDecisionTraceConfiguration, out *SchedulingDecisionTraceConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SchedulingDecisionTraceConfiguration_To_v1alpha1_SchedulingDecisionTraceConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SchedulingRateLimitConfiguration_To_config_SchedulingRateLimitConfiguration(in *SchedulingRateLimitConfiguration, out *config.SchedulingRateLimitConfiguration, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1alpha1_SchedulingRateLimitConfiguration_To_config_SchedulingRateLimitConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SchedulingRateLimitConfiguration_To_config_SchedulingRateLimitConfiguration(in *SchedulingRateLimitConfiguration, out *config.SchedulingRateLimitConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchedulingRateLimitConfiguration_To_config_SchedulingRateLimitConfiguration(in, out, s)
+}
+
+func autoConvert_config_SchedulingRateLimitConfiguration_To_v1alpha1_SchedulingRateLimitConfiguration(in *config.SchedulingRateLimitConfiguration, out *SchedulingRateLimitConfiguration, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_config_SchedulingRateLimitConfiguration_To_v1alpha1_SchedulingRateLimitConfiguration is an autogenerated conversion function.
+func Convert_config_SchedulingRateLimitConfiguration_To_v1alpha1_SchedulingRateLimitConfiguration(in *config.SchedulingRateLimitConfiguration, out *SchedulingRateLimitConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SchedulingRateLimitConfiguration_To_v1alpha1_SchedulingRateLimitConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedFlapDetectionConfiguration_To_config_SeedFlapDetectionConfiguration(in *SeedFlapDetectionConfiguration, out *config.SeedFlapDetectionConfiguration, s conversion.Scope) error {
+	out.MaxTransitions = in.MaxTransitions
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_v1alpha1_SeedFlapDetectionConfiguration_To_config_SeedFlapDetectionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedFlapDetectionConfiguration_To_config_SeedFlapDetectionConfiguration(in *SeedFlapDetectionConfiguration, out *config.SeedFlapDetectionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedFlapDetectionConfiguration_To_config_SeedFlapDetectionConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedFlapDetectionConfiguration_To_v1alpha1_SeedFlapDetectionConfiguration(in *config.SeedFlapDetectionConfiguration, out *SeedFlapDetectionConfiguration, s conversion.Scope) error {
+	out.MaxTransitions = in.MaxTransitions
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_config_SeedFlapDetectionConfiguration_To_v1alpha1_SeedFlapDetectionConfiguration is an autogenerated conversion function.
+func Convert_config_SeedFlapDetectionConfiguration_To_v1alpha1_SeedFlapDetectionConfiguration(in *config.SeedFlapDetectionConfiguration, out *SeedFlapDetectionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedFlapDetectionConfiguration_To_v1alpha1_SeedFlapDetectionConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -316,6 +628,25 @@ func autoConvert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.RetrySyncPeriod = in.RetrySyncPeriod
 	out.Strategy = config.CandidateDeterminationStrategy(in.Strategy)
+	out.SeedFlapDetection = (*config.SeedFlapDetectionConfiguration)(unsafe.Pointer(in.SeedFlapDetection))
+	out.BlockedSeeds = *(*[]string)(unsafe.Pointer(&in.BlockedSeeds))
+	out.HonorSeedCordoning = in.HonorSeedCordoning
+	out.HonorSeedSchedulingPauseWindows = in.HonorSeedSchedulingPauseWindows
+	out.SameOrganizationPreference = (*config.SameOrganizationPreferenceConfiguration)(unsafe.Pointer(in.SameOrganizationPreference))
+	out.ProductionSeedPreference = (*config.ProductionSeedPreferenceConfiguration)(unsafe.Pointer(in.ProductionSeedPreference))
+	out.LifetimePacking = (*config.LifetimePackingConfiguration)(unsafe.Pointer(in.LifetimePacking))
+	out.PreferSeedInstanceFamilies = in.PreferSeedInstanceFamilies
+	out.RateLimit = (*config.SchedulingRateLimitConfiguration)(unsafe.Pointer(in.RateLimit))
+	out.FairQueuing = in.FairQueuing
+	out.ComplianceTier = (*config.ComplianceTierConfiguration)(unsafe.Pointer(in.ComplianceTier))
+	out.DataResidency = (*config.DataResidencyConfiguration)(unsafe.Pointer(in.DataResidency))
+	out.DecisionAudit = (*config.SchedulingDecisionAuditConfiguration)(unsafe.Pointer(in.DecisionAudit))
+	out.DecisionTrace = (*config.SchedulingDecisionTraceConfiguration)(unsafe.Pointer(in.DecisionTrace))
+	out.PreferPreviousSeed = in.PreferPreviousSeed
+	out.BalancingStrategy = config.BalancingStrategy(in.BalancingStrategy)
+	out.RegistryProximity = (*config.RegistryProximityConfiguration)(unsafe.Pointer(in.RegistryProximity))
+	out.SpreadProjectShootsAcrossRegions = in.SpreadProjectShootsAcrossRegions
+	out.LogCandidateScores = in.LogCandidateScores
 	return nil
 }
 
@@ -328,6 +659,25 @@ func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.RetrySyncPeriod = in.RetrySyncPeriod
 	out.Strategy = CandidateDeterminationStrategy(in.Strategy)
+	out.SeedFlapDetection = (*SeedFlapDetectionConfiguration)(unsafe.Pointer(in.SeedFlapDetection))
+	out.BlockedSeeds = *(*[]string)(unsafe.Pointer(&in.BlockedSeeds))
+	out.HonorSeedCordoning = in.HonorSeedCordoning
+	out.HonorSeedSchedulingPauseWindows = in.HonorSeedSchedulingPauseWindows
+	out.SameOrganizationPreference = (*SameOrganizationPreferenceConfiguration)(unsafe.Pointer(in.SameOrganizationPreference))
+	out.ProductionSeedPreference = (*ProductionSeedPreferenceConfiguration)(unsafe.Pointer(in.ProductionSeedPreference))
+	out.LifetimePacking = (*LifetimePackingConfiguration)(unsafe.Pointer(in.LifetimePacking))
+	out.PreferSeedInstanceFamilies = in.PreferSeedInstanceFamilies
+	out.RateLimit = (*SchedulingRateLimitConfiguration)(unsafe.Pointer(in.RateLimit))
+	out.FairQueuing = in.FairQueuing
+	out.ComplianceTier = (*ComplianceTierConfiguration)(unsafe.Pointer(in.ComplianceTier))
+	out.DataResidency = (*DataResidencyConfiguration)(unsafe.Pointer(in.DataResidency))
+	out.DecisionAudit = (*SchedulingDecisionAuditConfiguration)(unsafe.Pointer(in.DecisionAudit))
+	out.DecisionTrace = (*SchedulingDecisionTraceConfiguration)(unsafe.Pointer(in.DecisionTrace))
+	out.PreferPreviousSeed = in.PreferPreviousSeed
+	out.BalancingStrategy = BalancingStrategy(in.BalancingStrategy)
+	out.RegistryProximity = (*RegistryProximityConfiguration)(unsafe.Pointer(in.RegistryProximity))
+	out.SpreadProjectShootsAcrossRegions = in.SpreadProjectShootsAcrossRegions
+	out.LogCandidateScores = in.LogCandidateScores
 	return nil
 }
 
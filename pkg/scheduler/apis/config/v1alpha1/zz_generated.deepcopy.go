@@ -42,6 +42,38 @@ func (in *BackupBucketSchedulerConfiguration) DeepCopy() *BackupBucketSchedulerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceTierConfiguration) DeepCopyInto(out *ComplianceTierConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceTierConfiguration.
+func (in *ComplianceTierConfiguration) DeepCopy() *ComplianceTierConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceTierConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataResidencyConfiguration) DeepCopyInto(out *DataResidencyConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataResidencyConfiguration.
+func (in *DataResidencyConfiguration) DeepCopy() *DataResidencyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DataResidencyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiscoveryConfiguration) DeepCopyInto(out *DiscoveryConfiguration) {
 	*out = *in
@@ -90,6 +122,70 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifetimePackingConfiguration) DeepCopyInto(out *LifetimePackingConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifetimePackingConfiguration.
+func (in *LifetimePackingConfiguration) DeepCopy() *LifetimePackingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LifetimePackingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductionSeedPreferenceConfiguration) DeepCopyInto(out *ProductionSeedPreferenceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductionSeedPreferenceConfiguration.
+func (in *ProductionSeedPreferenceConfiguration) DeepCopy() *ProductionSeedPreferenceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductionSeedPreferenceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryProximityConfiguration) DeepCopyInto(out *RegistryProximityConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryProximityConfiguration.
+func (in *RegistryProximityConfiguration) DeepCopy() *RegistryProximityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryProximityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SameOrganizationPreferenceConfiguration) DeepCopyInto(out *SameOrganizationPreferenceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SameOrganizationPreferenceConfiguration.
+func (in *SameOrganizationPreferenceConfiguration) DeepCopy() *SameOrganizationPreferenceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SameOrganizationPreferenceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerConfiguration) DeepCopyInto(out *SchedulerConfiguration) {
 	*out = *in
@@ -131,7 +227,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -146,6 +242,71 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingDecisionAuditConfiguration) DeepCopyInto(out *SchedulingDecisionAuditConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingDecisionAuditConfiguration.
+func (in *SchedulingDecisionAuditConfiguration) DeepCopy() *SchedulingDecisionAuditConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingDecisionAuditConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingDecisionTraceConfiguration) DeepCopyInto(out *SchedulingDecisionTraceConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingDecisionTraceConfiguration.
+func (in *SchedulingDecisionTraceConfiguration) DeepCopy() *SchedulingDecisionTraceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingDecisionTraceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingRateLimitConfiguration) DeepCopyInto(out *SchedulingRateLimitConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingRateLimitConfiguration.
+func (in *SchedulingRateLimitConfiguration) DeepCopy() *SchedulingRateLimitConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingRateLimitConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedFlapDetectionConfiguration) DeepCopyInto(out *SeedFlapDetectionConfiguration) {
+	*out = *in
+	out.Window = in.Window
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedFlapDetectionConfiguration.
+func (in *SeedFlapDetectionConfiguration) DeepCopy() *SeedFlapDetectionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedFlapDetectionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -183,6 +344,61 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
 	out.RetrySyncPeriod = in.RetrySyncPeriod
+	if in.SeedFlapDetection != nil {
+		in, out := &in.SeedFlapDetection, &out.SeedFlapDetection
+		*out = new(SeedFlapDetectionConfiguration)
+		**out = **in
+	}
+	if in.BlockedSeeds != nil {
+		in, out := &in.BlockedSeeds, &out.BlockedSeeds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SameOrganizationPreference != nil {
+		in, out := &in.SameOrganizationPreference, &out.SameOrganizationPreference
+		*out = new(SameOrganizationPreferenceConfiguration)
+		**out = **in
+	}
+	if in.ProductionSeedPreference != nil {
+		in, out := &in.ProductionSeedPreference, &out.ProductionSeedPreference
+		*out = new(ProductionSeedPreferenceConfiguration)
+		**out = **in
+	}
+	if in.LifetimePacking != nil {
+		in, out := &in.LifetimePacking, &out.LifetimePacking
+		*out = new(LifetimePackingConfiguration)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(SchedulingRateLimitConfiguration)
+		**out = **in
+	}
+	if in.ComplianceTier != nil {
+		in, out := &in.ComplianceTier, &out.ComplianceTier
+		*out = new(ComplianceTierConfiguration)
+		**out = **in
+	}
+	if in.DataResidency != nil {
+		in, out := &in.DataResidency, &out.DataResidency
+		*out = new(DataResidencyConfiguration)
+		**out = **in
+	}
+	if in.DecisionAudit != nil {
+		in, out := &in.DecisionAudit, &out.DecisionAudit
+		*out = new(SchedulingDecisionAuditConfiguration)
+		**out = **in
+	}
+	if in.DecisionTrace != nil {
+		in, out := &in.DecisionTrace, &out.DecisionTrace
+		*out = new(SchedulingDecisionTraceConfiguration)
+		**out = **in
+	}
+	if in.RegistryProximity != nil {
+		in, out := &in.RegistryProximity, &out.RegistryProximity
+		*out = new(RegistryProximityConfiguration)
+		**out = **in
+	}
 	return
 }
 
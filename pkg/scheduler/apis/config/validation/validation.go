@@ -22,10 +22,165 @@ import (
 
 // ValidateConfiguration validates the configuration.
 func ValidateConfiguration(config *schedulerapi.SchedulerConfiguration) error {
-	for _, strategy := range schedulerapi.Strategies {
-		if strategy == config.Schedulers.Shoot.Strategy {
+	if err := validateStrategy(config.Schedulers.Shoot.Strategy); err != nil {
+		return err
+	}
+	if err := validateSeedFlapDetection(config.Schedulers.Shoot.SeedFlapDetection); err != nil {
+		return err
+	}
+	if err := validateSameOrganizationPreference(config.Schedulers.Shoot.SameOrganizationPreference); err != nil {
+		return err
+	}
+	if err := validateProductionSeedPreference(config.Schedulers.Shoot.ProductionSeedPreference); err != nil {
+		return err
+	}
+	if err := validateLifetimePacking(config.Schedulers.Shoot.LifetimePacking); err != nil {
+		return err
+	}
+	if err := validateRateLimit(config.Schedulers.Shoot.RateLimit); err != nil {
+		return err
+	}
+	if err := validateComplianceTier(config.Schedulers.Shoot.ComplianceTier); err != nil {
+		return err
+	}
+	if err := validateDataResidency(config.Schedulers.Shoot.DataResidency); err != nil {
+		return err
+	}
+	if err := validateDecisionAudit(config.Schedulers.Shoot.DecisionAudit); err != nil {
+		return err
+	}
+	if err := validateDecisionTrace(config.Schedulers.Shoot.DecisionTrace); err != nil {
+		return err
+	}
+	if err := validateBalancingStrategy(config.Schedulers.Shoot.BalancingStrategy); err != nil {
+		return err
+	}
+	return validateRegistryProximity(config.Schedulers.Shoot.RegistryProximity)
+}
+
+func validateStrategy(strategy schedulerapi.CandidateDeterminationStrategy) error {
+	for _, s := range schedulerapi.Strategies {
+		if s == strategy {
 			return nil
 		}
 	}
-	return fmt.Errorf("unknown seed determination strategy configured in gardener scheduler. Strategy: '%s' does not exist. Valid strategies are: %v", config.Schedulers.Shoot.Strategy, schedulerapi.Strategies)
+	return fmt.Errorf("unknown seed determination strategy configured in gardener scheduler. Strategy: '%s' does not exist. Valid strategies are: %v", strategy, schedulerapi.Strategies)
+}
+
+func validateSeedFlapDetection(flapDetection *schedulerapi.SeedFlapDetectionConfiguration) error {
+	if flapDetection == nil {
+		return nil
+	}
+	if flapDetection.MaxTransitions < 0 {
+		return fmt.Errorf("seed flap detection configured in gardener scheduler must not have a negative number of maximum transitions (%d)", flapDetection.MaxTransitions)
+	}
+	if flapDetection.Window.Duration <= 0 {
+		return fmt.Errorf("seed flap detection configured in gardener scheduler must have a positive window (%s)", flapDetection.Window.Duration)
+	}
+	return nil
+}
+
+func validateSameOrganizationPreference(preference *schedulerapi.SameOrganizationPreferenceConfiguration) error {
+	if preference == nil {
+		return nil
+	}
+	if len(preference.SeedLabel) == 0 || len(preference.ShootAnnotation) == 0 {
+		return fmt.Errorf("same organization preference configured in gardener scheduler must specify both the seed label and the shoot annotation")
+	}
+	return nil
+}
+
+func validateProductionSeedPreference(preference *schedulerapi.ProductionSeedPreferenceConfiguration) error {
+	if preference == nil {
+		return nil
+	}
+	if len(preference.SeedLabel) == 0 || len(preference.ProductionTier) == 0 {
+		return fmt.Errorf("production seed preference configured in gardener scheduler must specify both the seed label and the production tier")
+	}
+	return nil
+}
+
+func validateLifetimePacking(lifetimePacking *schedulerapi.LifetimePackingConfiguration) error {
+	if lifetimePacking == nil {
+		return nil
+	}
+	if len(lifetimePacking.SeedLabel) == 0 {
+		return fmt.Errorf("lifetime packing configured in gardener scheduler must specify the seed label")
+	}
+	return nil
+}
+
+func validateRateLimit(rateLimit *schedulerapi.SchedulingRateLimitConfiguration) error {
+	if rateLimit == nil {
+		return nil
+	}
+	if rateLimit.QPS <= 0 {
+		return fmt.Errorf("rate limit configured in gardener scheduler must have a positive qps (%v)", rateLimit.QPS)
+	}
+	if rateLimit.Burst <= 0 {
+		return fmt.Errorf("rate limit configured in gardener scheduler must have a positive burst (%d)", rateLimit.Burst)
+	}
+	return nil
+}
+
+func validateComplianceTier(complianceTier *schedulerapi.ComplianceTierConfiguration) error {
+	if complianceTier == nil {
+		return nil
+	}
+	if len(complianceTier.SeedLabel) == 0 || len(complianceTier.ShootAnnotation) == 0 {
+		return fmt.Errorf("compliance tier configured in gardener scheduler must specify both the seed label and the shoot annotation")
+	}
+	return nil
+}
+
+func validateDataResidency(dataResidency *schedulerapi.DataResidencyConfiguration) error {
+	if dataResidency == nil {
+		return nil
+	}
+	if len(dataResidency.SeedLabel) == 0 {
+		return fmt.Errorf("data residency configured in gardener scheduler must specify the seed label")
+	}
+	return nil
+}
+
+func validateDecisionAudit(decisionAudit *schedulerapi.SchedulingDecisionAuditConfiguration) error {
+	if decisionAudit == nil {
+		return nil
+	}
+	if decisionAudit.MaxRecords <= 0 {
+		return fmt.Errorf("decision audit configured in gardener scheduler must keep a positive number of records (%d)", decisionAudit.MaxRecords)
+	}
+	return nil
+}
+
+func validateDecisionTrace(decisionTrace *schedulerapi.SchedulingDecisionTraceConfiguration) error {
+	if decisionTrace == nil {
+		return nil
+	}
+	if decisionTrace.MaxRecords <= 0 {
+		return fmt.Errorf("decision trace configured in gardener scheduler must keep a positive number of records (%d)", decisionTrace.MaxRecords)
+	}
+	return nil
+}
+
+func validateBalancingStrategy(strategy schedulerapi.BalancingStrategy) error {
+	if len(strategy) == 0 {
+		return nil
+	}
+	for _, s := range schedulerapi.BalancingStrategies {
+		if s == strategy {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown balancing strategy configured in gardener scheduler. Strategy: '%s' does not exist. Valid strategies are: %v", strategy, schedulerapi.BalancingStrategies)
+}
+
+func validateRegistryProximity(registryProximity *schedulerapi.RegistryProximityConfiguration) error {
+	if registryProximity == nil {
+		return nil
+	}
+	if len(registryProximity.SeedLabel) == 0 {
+		return fmt.Errorf("registry proximity configured in gardener scheduler must specify the seed label")
+	}
+	return nil
 }
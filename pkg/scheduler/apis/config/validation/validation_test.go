@@ -15,6 +15,8 @@
 package validation
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -65,6 +67,167 @@ var _ = Describe("gardener-scheduler", func() {
 
 				Expect(err).To(HaveOccurred())
 			})
+
+			It("should pass because the seed flap detection is a valid configuration", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.SeedFlapDetection = &schedulerapi.SeedFlapDetectionConfiguration{
+					MaxTransitions: 3,
+					Window:         metav1.Duration{Duration: time.Hour},
+				}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should fail because the seed flap detection has a negative number of maximum transitions", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.SeedFlapDetection = &schedulerapi.SeedFlapDetectionConfiguration{
+					MaxTransitions: -1,
+					Window:         metav1.Duration{Duration: time.Hour},
+				}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should pass because the same organization preference is a valid configuration", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.SameOrganizationPreference = &schedulerapi.SameOrganizationPreferenceConfiguration{
+					SeedLabel:       "seed.example.com/org",
+					ShootAnnotation: "shoot.example.com/org",
+				}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should fail because the same organization preference does not specify the shoot annotation", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.SameOrganizationPreference = &schedulerapi.SameOrganizationPreferenceConfiguration{
+					SeedLabel: "seed.example.com/org",
+				}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the production seed preference does not specify the production tier", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.ProductionSeedPreference = &schedulerapi.ProductionSeedPreferenceConfiguration{
+					SeedLabel: "seed.example.com/tier",
+				}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the lifetime packing does not specify the seed label", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.LifetimePacking = &schedulerapi.LifetimePackingConfiguration{}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the seed flap detection has no window", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.SeedFlapDetection = &schedulerapi.SeedFlapDetectionConfiguration{
+					MaxTransitions: 3,
+				}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should pass because the rate limit is a valid configuration", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.RateLimit = &schedulerapi.SchedulingRateLimitConfiguration{QPS: 0.5, Burst: 5}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should fail because the rate limit has no burst", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.RateLimit = &schedulerapi.SchedulingRateLimitConfiguration{QPS: 10}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the compliance tier does not specify the seed label", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.ComplianceTier = &schedulerapi.ComplianceTierConfiguration{
+					ShootAnnotation: "shoot.example.com/compliance-tier",
+				}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the data residency does not specify the seed label", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.DataResidency = &schedulerapi.DataResidencyConfiguration{}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the decision audit does not keep any records", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.DecisionAudit = &schedulerapi.SchedulingDecisionAuditConfiguration{}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the decision trace does not keep any records", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.DecisionTrace = &schedulerapi.SchedulingDecisionTraceConfiguration{}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should pass because the node count balancing strategy is a valid configuration", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.BalancingStrategy = schedulerapi.BalanceByNodeCount
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail because the balancing strategy does not exist", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.BalancingStrategy = "CPUCount"
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail because the registry proximity does not specify the seed label", func() {
+				configuration := *defaultAdmissionConfiguration.DeepCopy()
+				configuration.Schedulers.Shoot.Strategy = schedulerapi.SameRegion
+				configuration.Schedulers.Shoot.RegistryProximity = &schedulerapi.RegistryProximityConfiguration{}
+				err := ValidateConfiguration(&configuration)
+
+				Expect(err).To(HaveOccurred())
+			})
 		})
 	})
 })
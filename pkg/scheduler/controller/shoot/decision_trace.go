@@ -0,0 +1,134 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DecisionTracePath is the path of the debug endpoint serving the decision trace.
+const DecisionTracePath = "/debug/scheduling-decisions"
+
+// TracedSchedulingDecision is a scheduling decision kept in the decision trace of the scheduler.
+type TracedSchedulingDecision struct {
+	// Timestamp is the time when the decision was taken.
+	Timestamp metav1.Time `json:"timestamp"`
+	// Shoot is the namespace and name of the shoot.
+	Shoot string `json:"shoot"`
+	// Candidates are the seeds the shoot could have been scheduled to with their usage (shoots or worker nodes,
+	// depending on the balancing strategy).
+	Candidates []TracedCandidate `json:"candidates,omitempty"`
+	// Seed is the name of the seed the shoot has been scheduled to. It is empty if the scheduling failed.
+	Seed string `json:"seed,omitempty"`
+	// Reason explains why the seed has been chosen or why the scheduling failed.
+	Reason string `json:"reason"`
+}
+
+// TracedCandidate is a seed candidate of a traced scheduling decision.
+type TracedCandidate struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Usage is the usage of the seed at the time of the decision.
+	Usage int `json:"usage"`
+}
+
+// DecisionTrace is a ring buffer of the most recent scheduling decisions. It serves them JSON-encoded (oldest first)
+// as HTTP handler. A nil DecisionTrace records nothing.
+type DecisionTrace struct {
+	mutex   sync.Mutex
+	records []TracedSchedulingDecision
+	next    int
+	max     int
+}
+
+// NewDecisionTrace returns a decision trace according to the given configuration, or nil if no decisions shall be
+// traced.
+func NewDecisionTrace(decisionTrace *config.SchedulingDecisionTraceConfiguration) *DecisionTrace {
+	if decisionTrace == nil {
+		return nil
+	}
+	return &DecisionTrace{
+		records: make([]TracedSchedulingDecision, 0, decisionTrace.MaxRecords),
+		max:     decisionTrace.MaxRecords,
+	}
+}
+
+// record adds the given decision to the trace, replacing the oldest one if the trace is full.
+func (t *DecisionTrace) record(decision TracedSchedulingDecision) {
+	if t == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(t.records) < t.max {
+		t.records = append(t.records, decision)
+	} else {
+		t.records[t.next] = decision
+	}
+	t.next = (t.next + 1) % t.max
+}
+
+// Decisions returns the traced decisions, the oldest first.
+func (t *DecisionTrace) Decisions() []TracedSchedulingDecision {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	decisions := make([]TracedSchedulingDecision, 0, len(t.records))
+	decisions = append(decisions, t.records[t.next:]...)
+	return append(decisions, t.records[:t.next]...)
+}
+
+// ServeHTTP writes the traced decisions JSON-encoded to the response.
+func (t *DecisionTrace) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	data, err := json.Marshal(t.Decisions())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func newTracedSchedulingDecision(now time.Time, shoot *gardencorev1alpha1.Shoot, candidates []*gardencorev1alpha1.Seed, seedUsage map[string]int, seed *gardencorev1alpha1.Seed, strategy config.CandidateDeterminationStrategy, err error) TracedSchedulingDecision {
+	decision := TracedSchedulingDecision{
+		Timestamp: metav1.NewTime(now),
+		Shoot:     fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name),
+	}
+
+	for _, candidate := range candidates {
+		decision.Candidates = append(decision.Candidates, TracedCandidate{Name: candidate.Name, Usage: seedUsage[candidate.Name]})
+	}
+
+	if err != nil {
+		decision.Reason = err.Error()
+		return decision
+	}
+
+	decision.Seed = seed.Name
+	decision.Reason = fmt.Sprintf("least used of %d candidate(s) determined with %s strategy", len(candidates), strategy)
+	return decision
+}
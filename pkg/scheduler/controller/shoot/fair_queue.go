@@ -0,0 +1,126 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// fairQueue is a queue that hands out the keys of shoots alternately per project (i.e. namespace) instead of in the
+// order they were added. It drains the wrapped queue into per-project FIFO queues and dispatches them round-robin. The
+// drained keys remain in processing in the wrapped queue until they are marked as done, hence the wrapped queue still
+// de-duplicates keys and ensures that the same shoot is never processed concurrently.
+type fairQueue struct {
+	workqueue.RateLimitingInterface
+
+	lock     sync.Mutex
+	cond     *sync.Cond
+	projects []string
+	next     int
+	pending  map[string][]interface{}
+	drained  bool
+}
+
+// newFairQueue returns a fair queue wrapping the given queue and starts draining it.
+func newFairQueue(queue workqueue.RateLimitingInterface) *fairQueue {
+	q := &fairQueue{
+		RateLimitingInterface: queue,
+		pending:               map[string][]interface{}{},
+	}
+	q.cond = sync.NewCond(&q.lock)
+
+	go q.drain()
+	return q
+}
+
+// drain moves the keys of the wrapped queue to the queues of their projects until the wrapped queue is shut down.
+func (q *fairQueue) drain() {
+	for {
+		item, quit := q.RateLimitingInterface.Get()
+
+		q.lock.Lock()
+		if quit {
+			q.drained = true
+		} else {
+			project := projectOfKey(item)
+			if len(q.pending[project]) == 0 {
+				q.projects = append(q.projects, project)
+			}
+			q.pending[project] = append(q.pending[project], item)
+		}
+		q.cond.Broadcast()
+		q.lock.Unlock()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// Get blocks until a key is available and returns the oldest key of the next project in turn. It returns true as
+// second value once the wrapped queue has been shut down and all keys have been handed out.
+func (q *fairQueue) Get() (interface{}, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for len(q.projects) == 0 && !q.drained {
+		q.cond.Wait()
+	}
+	if len(q.projects) == 0 {
+		return nil, true
+	}
+
+	if q.next >= len(q.projects) {
+		q.next = 0
+	}
+	project := q.projects[q.next]
+	item := q.pending[project][0]
+
+	if q.pending[project] = q.pending[project][1:]; len(q.pending[project]) == 0 {
+		delete(q.pending, project)
+		q.projects = append(q.projects[:q.next], q.projects[q.next+1:]...)
+	} else {
+		q.next++
+	}
+	return item, false
+}
+
+// Len returns the number of keys which are queued, either in the wrapped queue or in the queues of the projects.
+func (q *fairQueue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	n := q.RateLimitingInterface.Len()
+	for _, items := range q.pending {
+		n += len(items)
+	}
+	return n
+}
+
+// projectOfKey returns the namespace of the given shoot key, which identifies the project of the shoot.
+func projectOfKey(item interface{}) string {
+	key, ok := item.(string)
+	if !ok {
+		return ""
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return ""
+	}
+	return namespace
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// shootsScheduled is a metric which counts the shoots scheduled successfully, grouped by the seed determination
+// strategy used for the scheduling decision.
+var shootsScheduled = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "gardener_scheduler_shoots_scheduled_total",
+	Help: "Total count of shoots scheduled successfully, grouped by the seed determination strategy used for the decision.",
+}, []string{"strategy"})
+
+// unschedulableShoots is a metric which reflects the current number of shoots without a seed which failed to be
+// scheduled at least once.
+var unschedulableShoots = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "gardener_scheduler_unschedulable_shoots",
+	Help: "Current number of shoots without a seed which failed to be scheduled at least once.",
+})
+
+// RegisterSchedulingMetrics registers the metrics about the scheduling decisions taken by the shoot scheduler.
+func RegisterSchedulingMetrics() {
+	prometheus.MustRegister(shootsScheduled, unschedulableShoots)
+}
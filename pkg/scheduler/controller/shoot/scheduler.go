@@ -30,6 +30,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/workqueue"
 )
 
@@ -53,13 +54,17 @@ type SchedulerController struct {
 	shootSynced cache.InformerSynced
 	shootQueue  workqueue.RateLimitingInterface
 
+	seedFlapTracker       *seedFlapTracker
+	unschedulableShoots   *unschedulableShootTracker
+	schedulingRateLimiter flowcontrol.RateLimiter
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
 
-// NewGardenerScheduler takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a <sharedInformerFactory>, a struct containing the scheduler configuration and a <recorder> for
-// event recording. It creates a new NewGardenerScheduler.
-func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.SchedulerConfiguration, recorder record.EventRecorder) *SchedulerController {
+// NewGardenerScheduler takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a <sharedInformerFactory>, a struct containing the scheduler configuration, a <recorder> for
+// event recording and a <decisionTrace> (may be nil) for tracing the scheduling decisions. It creates a new NewGardenerScheduler.
+func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.SchedulerConfiguration, recorder record.EventRecorder, decisionTrace *DecisionTrace) *SchedulerController {
 	var (
 		coreV1Alpha1Informer = gardenCoreInformerFactory.Core().V1alpha1()
 
@@ -70,24 +75,38 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInform
 		cloudProfileInformer = coreV1Alpha1Informer.CloudProfiles()
 		cloudProfileLister   = cloudProfileInformer.Lister()
 		shootQueue           = workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(config.Schedulers.Shoot.RetrySyncPeriod.Duration, 12*time.Hour), "gardener-shoot-scheduler")
+		seedFlapTracker      = newSeedFlapTracker()
+		unschedulableTracker = newUnschedulableShootTracker(unschedulableShoots)
 	)
 
+	if config.Schedulers.Shoot.FairQueuing {
+		shootQueue = newFairQueue(shootQueue)
+	}
+
 	schedulerController := &SchedulerController{
 		k8sGardenClient:        k8sGardenClient,
 		k8sGardenCoreInformers: gardenCoreInformerFactory,
-		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, recorder, config, shootLister, seedLister, cloudProfileLister),
+		control:                NewDefaultControl(k8sGardenClient, gardenCoreInformerFactory, recorder, config, shootLister, seedLister, cloudProfileLister, seedFlapTracker, unschedulableTracker, decisionTrace),
 		config:                 config,
 		recorder:               recorder,
 		cloudProfileLister:     cloudProfileLister,
 		seedLister:             seedLister,
 		shootQueue:             shootQueue,
 		shootLister:            shootLister,
+		seedFlapTracker:        seedFlapTracker,
+		unschedulableShoots:    unschedulableTracker,
+		schedulingRateLimiter:  newSchedulingRateLimiter(config.Schedulers.Shoot.RateLimit),
 		workerCh:               make(chan int),
 	}
 
 	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    schedulerController.shootAdd,
 		UpdateFunc: schedulerController.shootUpdate,
+		DeleteFunc: schedulerController.shootDelete,
+	})
+	seedInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: schedulerController.seedUpdate,
+		DeleteFunc: schedulerController.seedDelete,
 	})
 	schedulerController.cloudProfileSynced = cloudProfileInformer.Informer().HasSynced
 	schedulerController.seedSynced = seedInformer.Informer().HasSynced
@@ -96,6 +115,15 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInform
 	return schedulerController
 }
 
+// newSchedulingRateLimiter returns a token bucket limiting the scheduling throughput according to the given
+// configuration, or nil if the throughput shall not be limited.
+func newSchedulingRateLimiter(rateLimit *config.SchedulingRateLimitConfiguration) flowcontrol.RateLimiter {
+	if rateLimit == nil {
+		return nil
+	}
+	return flowcontrol.NewTokenBucketRateLimiter(rateLimit.QPS, rateLimit.Burst)
+}
+
 // Run runs the SchedulerController until the given stop channel can be read from.
 func (c *SchedulerController) Run(ctx context.Context, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory) {
 	var waitGroup sync.WaitGroup
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"sort"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// benchmarkScheduling runs the seed determination for every given shoot against the given seeds and returns the
+// average and the 95th percentile of the time it took to determine the best seed candidate. It is meant to detect
+// performance regressions in the candidate filtering, hence scheduling errors are ignored.
+func benchmarkScheduling(seeds []*gardencorev1alpha1.Seed, shoots []*gardencorev1alpha1.Shoot, strategy config.CandidateDeterminationStrategy) (time.Duration, time.Duration) {
+	var (
+		cloudProfile    = &gardencorev1alpha1.CloudProfile{}
+		schedulerConfig = &config.ShootSchedulerConfiguration{Strategy: strategy}
+		durations       = make([]time.Duration, 0, len(shoots))
+	)
+
+	for _, shoot := range shoots {
+		start := time.Now()
+		_, _, _ = determineBestSeedCandidate(shoot, cloudProfile, shoots, seeds, schedulerConfig, nil, nil)
+		durations = append(durations, time.Since(start))
+	}
+
+	return schedulingLatencies(durations)
+}
+
+// schedulingLatencies returns the average and the 95th percentile of the given durations.
+func schedulingLatencies(durations []time.Duration) (time.Duration, time.Duration) {
+	if len(durations) == 0 {
+		return 0, 0
+	}
+
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, duration := range sorted {
+		total += duration
+	}
+
+	p95Index := (len(sorted)*95+99)/100 - 1
+	return total / time.Duration(len(sorted)), sorted[p95Index]
+}
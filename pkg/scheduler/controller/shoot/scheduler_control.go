@@ -16,20 +16,28 @@ package shoot
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
+	operationcommon "github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 	"github.com/gardener/gardener/pkg/scheduler/controller/common"
 	schedulerutils "github.com/gardener/gardener/pkg/scheduler/utils"
+	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,6 +51,9 @@ import (
 // MsgUnschedulable is the Message for the Event on a Shoot that the Scheduler creates in case it cannot schedule the Shoot to any Seed
 const MsgUnschedulable = "Failed to schedule shoot"
 
+// ErrNoSeedsRegistered is returned if a shoot cannot be scheduled because no seeds are registered at all.
+var ErrNoSeedsRegistered = errors.New("no seeds registered")
+
 func (c *SchedulerController) shootAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
@@ -58,12 +69,14 @@ func (c *SchedulerController) shootAdd(obj interface{}) {
 
 	// If the Shoot manifest already specifies a desired Seed cluster, we ignore it.
 	if newShoot.Spec.SeedName != nil {
+		c.unschedulableShoots.forget(key)
 		return
 	}
 
 	if newShoot.DeletionTimestamp != nil {
 		logger.Logger.Infof("Ignoring shoot '%s' because it has been marked for deletion", newShoot.Name)
 		c.shootQueue.Forget(key)
+		c.unschedulableShoots.forget(key)
 		return
 	}
 
@@ -74,6 +87,39 @@ func (c *SchedulerController) shootUpdate(oldObj, newObj interface{}) {
 	c.shootAdd(newObj)
 }
 
+func (c *SchedulerController) shootDelete(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+
+	c.unschedulableShoots.forget(key)
+}
+
+func (c *SchedulerController) seedUpdate(oldObj, newObj interface{}) {
+	oldSeed, ok := oldObj.(*gardencorev1alpha1.Seed)
+	if !ok {
+		return
+	}
+	newSeed, ok := newObj.(*gardencorev1alpha1.Seed)
+	if !ok {
+		return
+	}
+
+	c.seedFlapTracker.observe(oldSeed, newSeed, c.config.Schedulers.Shoot.SeedFlapDetection, time.Now())
+}
+
+func (c *SchedulerController) seedDelete(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+
+	c.seedFlapTracker.forget(key)
+}
+
 func (c *SchedulerController) reconcileShootKey(ctx context.Context, key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -83,12 +129,18 @@ func (c *SchedulerController) reconcileShootKey(ctx context.Context, key string)
 	shoot, err := c.shootLister.Shoots(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
 		logger.Logger.Debugf("[SCHEDULER SHOOT RECONCILE] %s - skipping because Shoot has been deleted", key)
+		c.unschedulableShoots.forget(key)
 		return nil
 	}
 	if err != nil {
 		logger.Logger.Infof("[SCHEDULER SHOOT RECONCILE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+
+	// Wait for the scheduling rate limit, the remaining shoots stay in the queue meanwhile.
+	if c.schedulingRateLimiter != nil {
+		c.schedulingRateLimiter.Accept()
+	}
 	return c.control.ScheduleShoot(ctx, shoot, key)
 }
 
@@ -102,8 +154,8 @@ type SchedulerInterface interface {
 
 // NewDefaultControl returns a new instance of the default implementation SchedulerInterface that
 // implements the documented semantics for Scheduling.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.SchedulerConfiguration, shootLister gardencorelisters.ShootLister, seedLister gardencorelisters.SeedLister, cloudProfileLister gardencorelisters.CloudProfileLister) SchedulerInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenCoreInformers, recorder, config, shootLister, seedLister, cloudProfileLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.SchedulerConfiguration, shootLister gardencorelisters.ShootLister, seedLister gardencorelisters.SeedLister, cloudProfileLister gardencorelisters.CloudProfileLister, seedFlapTracker *seedFlapTracker, unschedulableShoots *unschedulableShootTracker, decisionTrace *DecisionTrace) SchedulerInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenCoreInformers, recorder, config, shootLister, seedLister, cloudProfileLister, seedFlapTracker, unschedulableShoots, decisionTrace}
 }
 
 type defaultControl struct {
@@ -114,6 +166,9 @@ type defaultControl struct {
 	shootLister            gardencorelisters.ShootLister
 	seedLister             gardencorelisters.SeedLister
 	cloudProfileLister     gardencorelisters.CloudProfileLister
+	seedFlapTracker        *seedFlapTracker
+	unschedulableShoots    *unschedulableShootTracker
+	decisionTrace          *DecisionTrace
 }
 
 type executeSchedulingRequest = func(context.Context, *gardencorev1alpha1.Shoot) error
@@ -127,11 +182,13 @@ func (c *defaultControl) ScheduleShoot(ctx context.Context, obj *gardencorev1alp
 	schedulerLogger.Infof("[SCHEDULING SHOOT] using %s strategy", c.config.Schedulers.Shoot.Strategy)
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := determineSeed(shoot, c.seedLister, c.shootLister, c.cloudProfileLister, c.config.Schedulers.Shoot.Strategy)
+	seed, candidates, err := determineSeed(shoot, c.seedLister, c.shootLister, c.cloudProfileLister, c.config.Schedulers.Shoot, c.seedFlapTracker, c.decisionTrace)
 	if err != nil {
+		c.unschedulableShoots.markUnschedulable(key)
 		c.reportFailedScheduling(shoot, err)
 		return err
 	}
+	decision := newSchedulingDecision(time.Now(), c.config.Schedulers.Shoot.Strategy, candidates, seed)
 
 	updateShoot := func(ctx context.Context, shootToUpdate *gardencorev1alpha1.Shoot) error {
 		// need retry logic, because the controller-manager is acting on it at the same time: setting Status to Pending until scheduled
@@ -140,7 +197,9 @@ func (c *defaultControl) ScheduleShoot(ctx context.Context, obj *gardencorev1alp
 				alreadyScheduledErr := common.NewAlreadyScheduledError(fmt.Sprintf("shoot has already a seed assigned when trying to schedule the shoot to %s", *shootToUpdate.Spec.SeedName))
 				return nil, &alreadyScheduledErr
 			}
-			shoot.Spec.SeedName = shootToUpdate.Spec.SeedName
+			if err := setScheduledSeed(shoot, *shootToUpdate.Spec.SeedName, decision, c.config.Schedulers.Shoot); err != nil {
+				return nil, err
+			}
 			return shoot, nil
 		})
 		return err
@@ -149,37 +208,90 @@ func (c *defaultControl) ScheduleShoot(ctx context.Context, obj *gardencorev1alp
 	if err := UpdateShootToBeScheduledOntoSeed(ctx, shoot, seed, updateShoot); err != nil {
 		// there was an external change while trying to schedule the shoot. The shoot is already scheduled. Fine, do not raise an error.
 		if _, ok := err.(*common.AlreadyScheduledError); ok {
+			c.unschedulableShoots.forget(key)
 			return nil
 		}
+		c.unschedulableShoots.markUnschedulable(key)
 		c.reportFailedScheduling(shoot, err)
 		return err
 	}
 
 	schedulerLogger.Infof("Shoot '%s' (Cloud Profile '%s', Region '%s') successfully scheduled to seed '%s' using SeedDeterminationStrategy '%s'", shoot.Name, shoot.Spec.CloudProfileName, shoot.Spec.Region, seed.Name, c.config.Schedulers.Shoot.Strategy)
-	c.reportSuccessfulScheduling(shoot, seed.Name)
+	c.unschedulableShoots.forget(key)
+	c.reportSuccessfulScheduling(shoot, decision)
+	return nil
+}
+
+// setScheduledSeed sets the seed the shoot is scheduled to and records the scheduling in the annotations of the shoot
+// as configured.
+func setScheduledSeed(shoot *gardencorev1alpha1.Shoot, seedName string, decision SchedulingDecision, schedulerConfig *config.ShootSchedulerConfiguration) error {
+	shoot.Spec.SeedName = &seedName
+	if schedulerConfig.PreferPreviousSeed {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1alpha1constants.AnnotationShootPreviousSeed, seedName)
+	}
+	if decisionAudit := schedulerConfig.DecisionAudit; decisionAudit != nil {
+		return recordSchedulingDecision(shoot, decision, decisionAudit.MaxRecords)
+	}
 	return nil
 }
 
-// determineSeed returns an appropriate Seed cluster (or nil).
-func determineSeed(shoot *gardencorev1alpha1.Shoot, seedLister gardencorelisters.SeedLister, shootLister gardencorelisters.ShootLister, cloudProfileLister gardencorelisters.CloudProfileLister, strategy config.CandidateDeterminationStrategy) (*gardencorev1alpha1.Seed, error) {
+// determineSeed returns an appropriate Seed cluster (or nil) and the candidates it was chosen from. The decision is
+// recorded in the given decision trace (may be nil).
+func determineSeed(shoot *gardencorev1alpha1.Shoot, seedLister gardencorelisters.SeedLister, shootLister gardencorelisters.ShootLister, cloudProfileLister gardencorelisters.CloudProfileLister, schedulerConfig *config.ShootSchedulerConfiguration, seedFlapTracker *seedFlapTracker, decisionTrace *DecisionTrace) (*gardencorev1alpha1.Seed, []*gardencorev1alpha1.Seed, error) {
 	seedList, err := seedLister.List(labels.Everything())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if len(seedList) == 0 {
+		decisionTrace.record(newTracedSchedulingDecision(time.Now(), shoot, nil, nil, nil, schedulerConfig.Strategy, ErrNoSeedsRegistered))
+		return nil, nil, ErrNoSeedsRegistered
 	}
 	shootList, err := shootLister.List(labels.Everything())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	cloudProfile, err := cloudProfileLister.Get(shoot.Spec.CloudProfileName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	return determineBestSeedCandidate(shoot, cloudProfile, shootList, seedList, schedulerConfig, seedFlapTracker, decisionTrace)
+}
+
+// determineBestSeedCandidate returns the least used of the seeds the shoot can be scheduled to and these candidates.
+// The decision is recorded in the given decision trace (may be nil) together with the usage of the candidates.
+func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *gardencorev1alpha1.CloudProfile, shootList []*gardencorev1alpha1.Shoot, seedList []*gardencorev1alpha1.Seed, schedulerConfig *config.ShootSchedulerConfiguration, seedFlapTracker *seedFlapTracker, decisionTrace *DecisionTrace) (*gardencorev1alpha1.Seed, []*gardencorev1alpha1.Seed, error) {
+	strategy := schedulerConfig.Strategy
+
+	candidates, err := determineSeedCandidates(shoot, cloudProfile, seedList, schedulerConfig, seedFlapTracker)
+	if err != nil {
+		decisionTrace.record(newTracedSchedulingDecision(time.Now(), shoot, nil, nil, nil, strategy, err))
+		return nil, nil, err
 	}
 
-	return determineBestSeedCandidate(shoot, cloudProfile, shootList, seedList, strategy)
+	seed, seedUsage := determineLeastUsedSeed(shoot, candidates, seedList, shootList, schedulerConfig)
+	decisionTrace.record(newTracedSchedulingDecision(time.Now(), shoot, candidates, seedUsage, seed, strategy, nil))
+	return seed, candidates, nil
 }
 
-func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *gardencorev1alpha1.CloudProfile, shootList []*gardencorev1alpha1.Shoot, seedList []*gardencorev1alpha1.Seed, strategy config.CandidateDeterminationStrategy) (*gardencorev1alpha1.Seed, error) {
-	var candidates []*gardencorev1alpha1.Seed
+// determineSeedCandidates returns all seeds the shoot can be scheduled to according to the configured strategy and
+// filters.
+func determineSeedCandidates(shoot *gardencorev1alpha1.Shoot, cloudProfile *gardencorev1alpha1.CloudProfile, seedList []*gardencorev1alpha1.Seed, schedulerConfig *config.ShootSchedulerConfiguration, seedFlapTracker *seedFlapTracker) ([]*gardencorev1alpha1.Seed, error) {
+	var (
+		candidates    []*gardencorev1alpha1.Seed
+		strategy      = schedulerConfig.Strategy
+		blockedSeeds  []string
+		cordonedSeeds []string
+		pausedSeeds   []string
+	)
+
+	seedList, blockedSeeds = filterBlockedSeeds(seedList, schedulerConfig.BlockedSeeds)
+	if schedulerConfig.HonorSeedCordoning {
+		seedList, cordonedSeeds = filterCordonedSeeds(seedList)
+	}
+	if schedulerConfig.HonorSeedSchedulingPauseWindows {
+		seedList, pausedSeeds = filterPausedSeeds(seedList, time.Now())
+	}
 	switch strategy {
 	case config.SameRegion:
 		candidates = determineCandidatesWithSameRegionStrategy(seedList, shoot, candidates)
@@ -190,7 +302,7 @@ func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *g
 	}
 
 	if candidates == nil {
-		return nil, fmt.Errorf("no matching seed found for Configuration (Cloud Profile '%s', Region '%s', SeedDeterminationStrategy '%s')", shoot.Spec.CloudProfileName, shoot.Spec.Region, strategy)
+		return nil, fmt.Errorf("no matching seed found for Configuration (Cloud Profile '%s', Region '%s', SeedDeterminationStrategy '%s')%s%s%s", shoot.Spec.CloudProfileName, shoot.Spec.Region, strategy, blockedSeedsReason(blockedSeeds), cordonedSeedsReason(cordonedSeeds), pausedSeedsReason(pausedSeeds))
 	}
 
 	selector := &metav1.LabelSelector{}
@@ -203,7 +315,10 @@ func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *g
 	}
 
 	// Filter out candidates
-	old := candidates
+	var (
+		old = candidates
+		now = time.Now()
+	)
 	candidates = nil
 
 	for _, seed := range old {
@@ -213,28 +328,115 @@ func determineBestSeedCandidate(shoot *gardencorev1alpha1.Shoot, cloudProfile *g
 		if !seedSelector.Matches(labels.Set(seed.Labels)) {
 			continue
 		}
+		if seedFlapTracker.isFlapping(seed.Name, schedulerConfig.SeedFlapDetection, now) {
+			continue
+		}
+		if len(gardencorev1alpha1helper.MissingSeedCapabilities(shoot.ObjectMeta, seed.ObjectMeta)) > 0 {
+			continue
+		}
 		candidates = append(candidates, seed)
 	}
 
 	if candidates == nil {
-		return nil, fmt.Errorf("found %d possible seed cluster(s), however none have a disjoint network", len(old))
+		return nil, fmt.Errorf("found %d possible seed cluster(s), however none have a disjoint network%s%s%s", len(old), blockedSeedsReason(blockedSeeds), cordonedSeedsReason(cordonedSeeds), pausedSeedsReason(pausedSeeds))
 	}
 
-	// Find the best candidate (i.e. the one managing the smallest number of shoots right now).
+	candidates, err = filterComplianceTier(candidates, shoot, schedulerConfig.ComplianceTier)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err = filterDataResidency(candidates, shoot, schedulerConfig.DataResidency)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates = packByLifetime(candidates, shoot, schedulerConfig.LifetimePacking)
+	return candidates, nil
+}
+
+// determineLeastUsedSeed returns the best candidate, i.e. the one managing the smallest number of shoots (or worker
+// nodes, depending on the balancing strategy) right now. Ties are broken in favor of the seed preferred by the soft
+// preferences of the shoot (see seedPreferences), then of the seed in the region hosting the fewest shoots of the same
+// project and finally of the seed closest to the container registry (each if configured). It also returns the usage of
+// the seeds the decision is based on.
+func determineLeastUsedSeed(shoot *gardencorev1alpha1.Shoot, candidates, seedList []*gardencorev1alpha1.Seed, shootList []*gardencorev1alpha1.Shoot, schedulerConfig *config.ShootSchedulerConfiguration) (*gardencorev1alpha1.Seed, map[string]int) {
 	var (
 		bestCandidate *gardencorev1alpha1.Seed
 		min           *int
-		seedUsage     = generateSeedUsageMap(shootList)
+		seedUsage     = generateSeedUsageMap(shootList, schedulerConfig.BalancingStrategy)
+		regionUsage   map[string]int
+		preferences   = seedPreferences(shoot, schedulerConfig)
 	)
 
+	if schedulerConfig.SpreadProjectShootsAcrossRegions {
+		regionUsage = generateProjectRegionUsageMap(shoot, seedList, shootList)
+	}
+
+	if schedulerConfig.LogCandidateScores && logger.Logger.IsLevelEnabled(logrus.DebugLevel) {
+		logCandidateScores(shoot, candidates, seedUsage, regionUsage, schedulerConfig)
+	}
+
 	for _, seed := range candidates {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
+		usage := seedUsage[seed.Name]
+		if min == nil || usage < *min {
+			bestCandidate = seed
+			min = &usage
+			continue
+		}
+		if usage > *min {
+			continue
+		}
+
+		if preferred, decided := comparePreferences(preferences, seed, bestCandidate); decided {
+			if preferred {
+				bestCandidate = seed
+			}
+			continue
+		}
+		if seedRegionUsage, bestRegionUsage := regionUsage[seed.Spec.Provider.Region], regionUsage[bestCandidate.Spec.Provider.Region]; seedRegionUsage != bestRegionUsage {
+			if seedRegionUsage < bestRegionUsage {
+				bestCandidate = seed
+			}
+			continue
+		}
+		if registryProximity(seed, schedulerConfig.RegistryProximity) > registryProximity(bestCandidate, schedulerConfig.RegistryProximity) {
 			bestCandidate = seed
-			min = &numberOfManagedShoots
 		}
 	}
 
-	return bestCandidate, nil
+	return bestCandidate, seedUsage
+}
+
+// logCandidateScores logs the scores of all candidates the least used seed is chosen from at debug level.
+func logCandidateScores(shoot *gardencorev1alpha1.Shoot, candidates []*gardencorev1alpha1.Seed, seedUsage, regionUsage map[string]int, schedulerConfig *config.ShootSchedulerConfiguration) {
+	for _, seed := range candidates {
+		logger.Logger.Debugf("[SCHEDULING SHOOT] %s/%s - candidate seed '%s': usage=%d regionDistance=%d projectRegionUsage=%d registryProximity=%d",
+			shoot.Namespace, shoot.Name, seed.Name, seedUsage[seed.Name], regionDistance(shoot.Spec.Region, seed.Spec.Provider.Region), regionUsage[seed.Spec.Provider.Region], registryProximity(seed, schedulerConfig.RegistryProximity))
+	}
+}
+
+// regionDistance returns the number of characters of the shoot region which are not matched by the common prefix of
+// the seed region, i.e. zero if both regions are equal.
+func regionDistance(shootRegion, seedRegion string) int {
+	matching := 0
+	for matching < len(shootRegion) && matching < len(seedRegion) && shootRegion[matching] == seedRegion[matching] {
+		matching++
+	}
+	return len(shootRegion) - matching
+}
+
+// registryProximity returns the registry proximity score advertised by the given seed. Seeds without a valid score
+// have the lowest proximity.
+func registryProximity(seed *gardencorev1alpha1.Seed, registryProximity *config.RegistryProximityConfiguration) int {
+	if registryProximity == nil {
+		return 0
+	}
+	score, err := strconv.Atoi(seed.Labels[registryProximity.SeedLabel])
+	if err != nil || score < 0 {
+		return 0
+	}
+	return score
 }
 
 func determineCandidatesWithSameRegionStrategy(seedList []*gardencorev1alpha1.Seed, shoot *gardencorev1alpha1.Shoot, candidates []*gardencorev1alpha1.Seed) []*gardencorev1alpha1.Seed {
@@ -277,11 +479,321 @@ func determineCandidatesWithMinimalDistanceStrategy(seeds []*gardencorev1alpha1.
 	return candidates
 }
 
-func generateSeedUsageMap(shootList []*gardencorev1alpha1.Shoot) map[string]int {
+// seedPreference reports whether the given seed is preferred by a soft preference of a shoot.
+type seedPreference func(seed *gardencorev1alpha1.Seed) bool
+
+// seedPreferences returns the soft preferences which are configured and apply to the shoot, ordered by precedence.
+// Soft preferences do not exclude any candidate, they only break ties between equally used candidates.
+func seedPreferences(shoot *gardencorev1alpha1.Shoot, schedulerConfig *config.ShootSchedulerConfiguration) []seedPreference {
+	var preferences []seedPreference
+	for _, preference := range []seedPreference{
+		sameOrganizationPreference(shoot, schedulerConfig.SameOrganizationPreference),
+		productionSeedPreference(shoot, schedulerConfig.ProductionSeedPreference),
+		instanceFamilyPreference(shoot, schedulerConfig.PreferSeedInstanceFamilies),
+		previousSeedPreference(shoot, schedulerConfig.PreferPreviousSeed),
+	} {
+		if preference != nil {
+			preferences = append(preferences, preference)
+		}
+	}
+	return preferences
+}
+
+// comparePreferences compares the given seed with the other seed according to the given preferences. It returns
+// whether the seed is preferred over the other one and whether any of the preferences decides between them.
+func comparePreferences(preferences []seedPreference, seed, other *gardencorev1alpha1.Seed) (bool, bool) {
+	for _, preference := range preferences {
+		if seedPreferred, otherPreferred := preference(seed), preference(other); seedPreferred != otherPreferred {
+			return seedPreferred, true
+		}
+	}
+	return false, false
+}
+
+// sameOrganizationPreference prefers the seeds that are owned by the organization the shoot prefers. It returns nil if
+// the preference is not configured or the shoot does not state a preferred organization.
+func sameOrganizationPreference(shoot *gardencorev1alpha1.Shoot, preference *config.SameOrganizationPreferenceConfiguration) seedPreference {
+	if preference == nil {
+		return nil
+	}
+
+	organization, ok := shoot.Annotations[preference.ShootAnnotation]
+	if !ok || len(organization) == 0 {
+		return nil
+	}
+
+	return func(seed *gardencorev1alpha1.Seed) bool {
+		return seed.Labels[preference.SeedLabel] == organization
+	}
+}
+
+// productionSeedPreference prefers the production-grade seeds for shoots with the purpose `production`. It returns nil
+// if the preference is not configured or the shoot has another purpose.
+func productionSeedPreference(shoot *gardencorev1alpha1.Shoot, preference *config.ProductionSeedPreferenceConfiguration) seedPreference {
+	if preference == nil || shoot.Annotations[v1alpha1constants.GardenPurpose] != v1alpha1constants.ShootPurposeProduction {
+		return nil
+	}
+
+	return func(seed *gardencorev1alpha1.Seed) bool {
+		return seed.Labels[preference.SeedLabel] == preference.ProductionTier
+	}
+}
+
+// packByLifetime returns the candidates designated for short-lived shoots if the shoot is short-lived, and the other
+// candidates otherwise. If no candidate matches, all candidates are returned. In contrast to the seed preferences, it
+// narrows the candidates before their usage is compared, as packing the shoots is its purpose.
+func packByLifetime(candidates []*gardencorev1alpha1.Seed, shoot *gardencorev1alpha1.Shoot, lifetimePacking *config.LifetimePackingConfiguration) []*gardencorev1alpha1.Seed {
+	if lifetimePacking == nil {
+		return candidates
+	}
+
+	var (
+		shortLived = isShortLivedShoot(shoot)
+		preferred  []*gardencorev1alpha1.Seed
+	)
+	for _, seed := range candidates {
+		if (seed.Labels[lifetimePacking.SeedLabel] == "true") == shortLived {
+			preferred = append(preferred, seed)
+		}
+	}
+
+	if len(preferred) == 0 {
+		return candidates
+	}
+	return preferred
+}
+
+// isShortLivedShoot returns true if the shoot has the purpose `evaluation` or an expiration timestamp.
+func isShortLivedShoot(shoot *gardencorev1alpha1.Shoot) bool {
+	if shoot.Annotations[v1alpha1constants.GardenPurpose] == v1alpha1constants.ShootPurposeEvaluation {
+		return true
+	}
+	_, ok := shoot.Annotations[operationcommon.ShootExpirationTimestamp]
+	return ok
+}
+
+// instanceFamilyPreference prefers the seeds advertising the instance families of the machine types of all worker
+// pools of the shoot. It returns nil if the preference is disabled or the shoot has no worker pools.
+func instanceFamilyPreference(shoot *gardencorev1alpha1.Shoot, enabled bool) seedPreference {
+	if !enabled || len(shoot.Spec.Provider.Workers) == 0 {
+		return nil
+	}
+
+	return func(seed *gardencorev1alpha1.Seed) bool {
+		for _, worker := range shoot.Spec.Provider.Workers {
+			if !seedSupportsMachineType(seed, worker.Machine.Type) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// seedSupportsMachineType returns true if the seed advertises the instance family of the given machine type, i.e. the
+// machine type equals an advertised family or starts with it followed by a separator (e.g. `m5.large` for `m5`).
+func seedSupportsMachineType(seed *gardencorev1alpha1.Seed, machineType string) bool {
+	prefix := v1alpha1constants.LabelSeedCapabilityPrefix + v1alpha1constants.SeedCapabilityInstanceFamilyPrefix
+	for key, value := range seed.Labels {
+		if value != "true" || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		family := strings.TrimPrefix(key, prefix)
+		if len(family) == 0 || !strings.HasPrefix(machineType, family) {
+			continue
+		}
+		if rest := strings.TrimPrefix(machineType, family); len(rest) == 0 || strings.ContainsAny(rest[:1], ".-_") {
+			return true
+		}
+	}
+	return false
+}
+
+// previousSeedPreference prefers the seed the shoot was previously scheduled to. It returns nil if the preference is
+// disabled or the shoot was not scheduled before.
+func previousSeedPreference(shoot *gardencorev1alpha1.Shoot, enabled bool) seedPreference {
+	if !enabled {
+		return nil
+	}
+
+	previousSeed, ok := shoot.Annotations[v1alpha1constants.AnnotationShootPreviousSeed]
+	if !ok || len(previousSeed) == 0 {
+		return nil
+	}
+
+	return func(seed *gardencorev1alpha1.Seed) bool {
+		return seed.Name == previousSeed
+	}
+}
+
+// filterComplianceTier returns the candidates whose compliance tier is equal to or higher than the one required by the
+// shoot. Seeds without a valid compliance tier are not eligible for shoots requiring one. It fails if the shoot requires
+// an invalid compliance tier or no candidate is compliant.
+func filterComplianceTier(candidates []*gardencorev1alpha1.Seed, shoot *gardencorev1alpha1.Shoot, complianceTier *config.ComplianceTierConfiguration) ([]*gardencorev1alpha1.Seed, error) {
+	if complianceTier == nil {
+		return candidates, nil
+	}
+
+	value, ok := shoot.Annotations[complianceTier.ShootAnnotation]
+	if !ok {
+		return candidates, nil
+	}
+	requiredTier, err := strconv.Atoi(value)
+	if err != nil || requiredTier < 0 {
+		return nil, fmt.Errorf("shoot requires an invalid compliance tier %q in annotation %s", value, complianceTier.ShootAnnotation)
+	}
+
+	var compliant []*gardencorev1alpha1.Seed
+	for _, seed := range candidates {
+		if tier, err := strconv.Atoi(seed.Labels[complianceTier.SeedLabel]); err == nil && tier >= requiredTier {
+			compliant = append(compliant, seed)
+		}
+	}
+
+	if len(compliant) == 0 {
+		return nil, fmt.Errorf("found %d possible seed cluster(s), however none have a compliance tier of at least %d", len(candidates), requiredTier)
+	}
+	return compliant, nil
+}
+
+// filterDataResidency returns the candidates located in the jurisdiction required by the data residency of the shoot.
+// Seeds without a jurisdiction are not eligible for shoots requiring one. It fails if no candidate is located in the
+// jurisdiction or if the jurisdiction of the seeds is not configured.
+func filterDataResidency(candidates []*gardencorev1alpha1.Seed, shoot *gardencorev1alpha1.Shoot, dataResidency *config.DataResidencyConfiguration) ([]*gardencorev1alpha1.Seed, error) {
+	if shoot.Spec.DataResidency == nil {
+		return candidates, nil
+	}
+
+	jurisdiction := *shoot.Spec.DataResidency
+	if dataResidency == nil {
+		return nil, fmt.Errorf("the data residency of the shoot requires the jurisdiction %q, however the jurisdiction of the seeds is not configured", jurisdiction)
+	}
+
+	var resident []*gardencorev1alpha1.Seed
+	for _, seed := range candidates {
+		if seed.Labels[dataResidency.SeedLabel] == jurisdiction {
+			resident = append(resident, seed)
+		}
+	}
+
+	if len(resident) == 0 {
+		return nil, fmt.Errorf("found %d possible seed cluster(s), however none are located in the jurisdiction %q required by the data residency of the shoot", len(candidates), jurisdiction)
+	}
+	return resident, nil
+}
+
+// filterBlockedSeeds removes all seeds whose names are contained in the given list of blocked seeds. It returns the
+// remaining seeds and the names of the removed ones.
+func filterBlockedSeeds(seedList []*gardencorev1alpha1.Seed, blockedSeedNames []string) ([]*gardencorev1alpha1.Seed, []string) {
+	if len(blockedSeedNames) == 0 {
+		return seedList, nil
+	}
+
+	var (
+		seeds   []*gardencorev1alpha1.Seed
+		blocked []string
+	)
+
+	for _, seed := range seedList {
+		if utils.ValueExists(seed.Name, blockedSeedNames) {
+			blocked = append(blocked, seed.Name)
+			continue
+		}
+		seeds = append(seeds, seed)
+	}
+
+	return seeds, blocked
+}
+
+func blockedSeedsReason(blockedSeeds []string) string {
+	if len(blockedSeeds) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (excluded blocked seed(s): %s)", strings.Join(blockedSeeds, ", "))
+}
+
+// filterCordonedSeeds removes all seeds which are annotated as cordoned. It returns the remaining seeds and the names of
+// the removed ones.
+func filterCordonedSeeds(seedList []*gardencorev1alpha1.Seed) ([]*gardencorev1alpha1.Seed, []string) {
+	var (
+		seeds    []*gardencorev1alpha1.Seed
+		cordoned []string
+	)
+
+	for _, seed := range seedList {
+		if seed.Annotations[v1alpha1constants.AnnotationSeedCordoned] == "true" {
+			cordoned = append(cordoned, seed.Name)
+			continue
+		}
+		seeds = append(seeds, seed)
+	}
+
+	return seeds, cordoned
+}
+
+func cordonedSeedsReason(cordonedSeeds []string) string {
+	if len(cordonedSeeds) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (excluded cordoned seed(s): %s)", strings.Join(cordonedSeeds, ", "))
+}
+
+// filterPausedSeeds removes all seeds whose scheduling pause window contains the given time. It returns the remaining
+// seeds and the names of the removed ones. Seeds with an invalid pause window are not removed.
+func filterPausedSeeds(seedList []*gardencorev1alpha1.Seed, now time.Time) ([]*gardencorev1alpha1.Seed, []string) {
+	var (
+		seeds  []*gardencorev1alpha1.Seed
+		paused []string
+	)
+
+	for _, seed := range seedList {
+		if seedSchedulingPaused(seed, now) {
+			paused = append(paused, seed.Name)
+			continue
+		}
+		seeds = append(seeds, seed)
+	}
+
+	return seeds, paused
+}
+
+func seedSchedulingPaused(seed *gardencorev1alpha1.Seed, now time.Time) bool {
+	begin, ok := seed.Annotations[v1alpha1constants.AnnotationSeedSchedulingPauseWindowBegin]
+	if !ok {
+		return false
+	}
+	end, ok := seed.Annotations[v1alpha1constants.AnnotationSeedSchedulingPauseWindowEnd]
+	if !ok {
+		return false
+	}
+
+	pauseWindow, err := utils.ParseMaintenanceTimeWindow(begin, end)
+	if err != nil {
+		logger.Logger.Warnf("Ignoring invalid scheduling pause window of seed '%s': %v", seed.Name, err)
+		return false
+	}
+	return pauseWindow.Contains(now)
+}
+
+func pausedSeedsReason(pausedSeeds []string) string {
+	if len(pausedSeeds) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (excluded seed(s) within their scheduling pause window: %s)", strings.Join(pausedSeeds, ", "))
+}
+
+func generateSeedUsageMap(shootList []*gardencorev1alpha1.Shoot, strategy config.BalancingStrategy) map[string]int {
 	m := map[string]int{}
 
 	for _, shoot := range shootList {
-		if seed := shoot.Spec.SeedName; seed != nil {
+		seed := shoot.Spec.SeedName
+		if seed == nil {
+			continue
+		}
+
+		switch strategy {
+		case config.BalanceByNodeCount:
+			m[*seed] += maximumNumberOfWorkerNodes(shoot)
+		default:
 			m[*seed]++
 		}
 	}
@@ -289,6 +801,39 @@ func generateSeedUsageMap(shootList []*gardencorev1alpha1.Shoot) map[string]int
 	return m
 }
 
+// generateProjectRegionUsageMap returns the number of shoots of the project of the given shoot (i.e., in the same
+// namespace) per region of the seeds they are scheduled to.
+func generateProjectRegionUsageMap(shoot *gardencorev1alpha1.Shoot, seedList []*gardencorev1alpha1.Seed, shootList []*gardencorev1alpha1.Shoot) map[string]int {
+	var (
+		m           = map[string]int{}
+		seedRegions = make(map[string]string, len(seedList))
+	)
+
+	for _, seed := range seedList {
+		seedRegions[seed.Name] = seed.Spec.Provider.Region
+	}
+
+	for _, s := range shootList {
+		if s.Namespace != shoot.Namespace || s.Name == shoot.Name || s.Spec.SeedName == nil {
+			continue
+		}
+		if region, ok := seedRegions[*s.Spec.SeedName]; ok {
+			m[region]++
+		}
+	}
+
+	return m
+}
+
+// maximumNumberOfWorkerNodes returns the sum of the maximum number of nodes of all worker pools of the given shoot.
+func maximumNumberOfWorkerNodes(shoot *gardencorev1alpha1.Shoot) int {
+	var nodes int
+	for _, worker := range shoot.Spec.Provider.Workers {
+		nodes += int(worker.Maximum)
+	}
+	return nodes
+}
+
 func networksAreDisjunct(seed *gardencorev1alpha1.Seed, shoot *gardencorev1alpha1.Shoot) bool {
 	return len(schedulerutils.ValidateNetworkDisjointedness(seed.Spec.Networks, shoot.Spec.Networking.Nodes, shoot.Spec.Networking.Pods, shoot.Spec.Networking.Services, field.NewPath(""))) == 0
 }
@@ -307,11 +852,16 @@ func UpdateShootToBeScheduledOntoSeed(ctx context.Context, shoot *gardencorev1al
 }
 
 func (c *defaultControl) reportFailedScheduling(shoot *gardencorev1alpha1.Shoot, err error) {
-	c.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1alpha1.ShootEventSchedulingFailed, MsgUnschedulable+" '%s' : %+v", shoot.Name, err)
+	reason := gardencorev1alpha1.ShootEventSchedulingFailed
+	if err == ErrNoSeedsRegistered {
+		reason = gardencorev1alpha1.ShootEventNoSeedsRegistered
+	}
+	c.reportEvent(shoot, corev1.EventTypeWarning, reason, MsgUnschedulable+" '%s' : %+v", shoot.Name, err)
 }
 
-func (c *defaultControl) reportSuccessfulScheduling(shoot *gardencorev1alpha1.Shoot, seedName string) {
-	c.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1alpha1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seedName)
+func (c *defaultControl) reportSuccessfulScheduling(shoot *gardencorev1alpha1.Shoot, decision SchedulingDecision) {
+	shootsScheduled.With(prometheus.Labels{"strategy": string(decision.Strategy)}).Inc()
+	c.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1alpha1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", decision.Seed)
 }
 
 func (c *defaultControl) reportEvent(project *gardencorev1alpha1.Shoot, eventType string, eventReason, messageFmt string, args ...interface{}) {
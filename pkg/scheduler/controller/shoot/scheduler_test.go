@@ -15,18 +15,31 @@
 package shoot
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	"github.com/gardener/gardener/pkg/logger"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 )
 
 var _ = Describe("Scheduler_Control", func() {
@@ -132,7 +145,7 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seed.Name))
@@ -154,12 +167,50 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
 
+		It("should record the decision with the usage of the candidates in the decision trace", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&secondSeed)
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &seed.Name
+
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			trace := NewDecisionTrace(&config.SchedulingDecisionTraceConfiguration{MaxRecords: 1})
+			_, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, trace)
+
+			Expect(err).NotTo(HaveOccurred())
+			decisions := trace.Decisions()
+			Expect(decisions).To(HaveLen(1))
+			Expect(decisions[0].Candidates).To(ConsistOf(TracedCandidate{Name: seed.Name, Usage: 1}, TracedCandidate{Name: secondSeed.Name, Usage: 0}))
+			Expect(decisions[0].Seed).To(Equal(secondSeed.Name))
+		})
+
+		It("should record a failed decision in the decision trace", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			trace := NewDecisionTrace(&config.SchedulingDecisionTraceConfiguration{MaxRecords: 1})
+			_, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, trace)
+
+			Expect(err).To(Equal(ErrNoSeedsRegistered))
+			decisions := trace.Decisions()
+			Expect(decisions).To(HaveLen(1))
+			Expect(decisions[0].Seed).To(BeEmpty())
+			Expect(decisions[0].Reason).To(Equal(ErrNoSeedsRegistered.Error()))
+		})
+
 		// FAIL
 
 		It("should fail because it cannot find a seed cluster 1) 'Same Region' seed determination strategy 2) region that no seed supports", func() {
@@ -169,7 +220,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -192,7 +243,7 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -206,7 +257,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -236,7 +287,7 @@ var _ = Describe("Scheduler_Control", func() {
 			anotherRegion := "europe-west3"
 			shoot.Spec.Region = anotherRegion
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -260,7 +311,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -283,7 +334,7 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -304,7 +355,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -322,7 +373,7 @@ var _ = Describe("Scheduler_Control", func() {
 				Nodes:    seed.Spec.Networks.Nodes,
 			}
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -334,7 +385,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			shoot.Spec.Region = "another-region"
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -350,7 +401,7 @@ var _ = Describe("Scheduler_Control", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -362,7 +413,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			shoot.Spec.CloudProfileName = "another-profile"
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -379,7 +430,7 @@ var _ = Describe("Scheduler_Control", func() {
 			}
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -393,39 +444,1589 @@ var _ = Describe("Scheduler_Control", func() {
 			}
 			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
 	})
 
-	Context("Scheduling", func() {
-		var (
-			shoot = shootBase.DeepCopy()
-			seed  = *seedBase.DeepCopy()
-		)
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - exclude seeds with a flapping availability", func() {
+		var flapTracker *seedFlapTracker
 
-		It("should request the scheduling of the shoot to the seed", func() {
-			var runtimeClient = mockclient.NewMockClient(ctrl)
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			flapTracker = newSeedFlapTracker()
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.SeedFlapDetection = &config.SeedFlapDetectionConfiguration{
+				MaxTransitions: 2,
+				Window:         metav1.Duration{Duration: time.Hour},
+			}
+		})
 
-			shoot.Spec.SeedName = &seed.Name
-			runtimeClient.EXPECT().Update(context.TODO(), shoot).DoAndReturn(func(ctx context.Context, list runtime.Object) error {
-				return nil
-			})
+		flap := func(seed *gardencorev1alpha1.Seed, times int, transitionTime time.Time) {
+			for i := 0; i < times; i++ {
+				oldSeed, newSeed := seed.DeepCopy(), seed.DeepCopy()
+				oldSeed.Status.Conditions[0].Status = gardencorev1alpha1.ConditionFalse
+				newSeed.Status.Conditions[0].LastTransitionTime = metav1.NewTime(transitionTime)
+				flapTracker.observe(oldSeed, newSeed, schedulerConfiguration.Schedulers.Shoot.SeedFlapDetection, time.Now())
+			}
+		}
 
-			executeSchedulingRequest := func(ctx context.Context, shoot *gardencorev1alpha1.Shoot) error {
-				return runtimeClient.Update(ctx, shoot)
+		It("should exclude the flapping seed and select the stable one", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			stableSeed := *seedBase.DeepCopy()
+			stableSeed.Name = "seed-2"
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&stableSeed)
+
+			// the stable seed manages more shoots -> it would not be selected if the flapping seed was a candidate
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &stableSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			flap(&seed, 3, time.Now())
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, flapTracker, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(stableSeed.Name))
+		})
+
+		It("should include a seed whose transitions do not exceed the threshold", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			flap(&seed, 2, time.Now())
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, flapTracker, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should include a seed whose transitions happened before the window", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			flap(&seed, 3, time.Now().Add(-2*time.Hour))
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, flapTracker, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should include a flapping seed if the flap detection is not configured", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			flap(&seed, 3, time.Now())
+			schedulerConfiguration.Schedulers.Shoot.SeedFlapDetection = nil
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, flapTracker, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should not record a transition if the availability did not change", func() {
+			oldSeed, newSeed := seed.DeepCopy(), seed.DeepCopy()
+			newSeed.Status.Conditions[0].LastTransitionTime = metav1.Now()
+
+			for i := 0; i < 3; i++ {
+				flapTracker.observe(oldSeed, newSeed, schedulerConfiguration.Schedulers.Shoot.SeedFlapDetection, time.Now())
 			}
 
-			err := UpdateShootToBeScheduledOntoSeed(context.TODO(), shoot, &seed, executeSchedulingRequest)
+			Expect(flapTracker.isFlapping(seed.Name, schedulerConfiguration.Schedulers.Shoot.SeedFlapDetection, time.Now())).To(BeFalse())
+		})
+
+		It("should not record transitions if the flap detection is not configured", func() {
+			flapDetection := schedulerConfiguration.Schedulers.Shoot.SeedFlapDetection
+			schedulerConfiguration.Schedulers.Shoot.SeedFlapDetection = nil
+
+			flap(&seed, 3, time.Now())
+
+			Expect(flapTracker.transitions).To(BeEmpty())
+			Expect(flapTracker.isFlapping(seed.Name, flapDetection, time.Now())).To(BeFalse())
+		})
+
+		It("should discard transitions older than the window when recording new ones", func() {
+			flap(&seed, 3, time.Now().Add(-2*time.Hour))
+			flap(&seed, 1, time.Now())
+
+			Expect(flapTracker.transitions[seed.Name]).To(HaveLen(1))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - exclude blocked seeds", func() {
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.BlockedSeeds = []string{seed.Name}
+		})
+
+		It("should exclude the blocked seed although it manages the least shoots", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			secondSeed := *seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&secondSeed)
+
+			// second seed references more shoots than the blocked seed -> the blocked seed would be the best candidate
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &secondSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should fail and mention the blocked seed if it was the only candidate", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(MatchError(ContainSubstring("excluded blocked seed(s): " + seed.Name)))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should fall back to a seed in another region if the seed in the same region is blocked", func() {
+			schedulerConfiguration.Schedulers.Shoot.Strategy = config.MinimalDistance
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			otherRegionSeed := *seedBase.DeepCopy()
+			otherRegionSeed.Name = "seed-2"
+			otherRegionSeed.Spec.Provider.Region = "eu-west"
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&otherRegionSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
 
 			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(otherRegionSeed.Name))
 		})
 	})
-})
 
-func makeStrPtr(v string) *string {
-	c := string(v)
-	return &c
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - exclude cordoned seeds", func() {
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.HonorSeedCordoning = true
+			seed.Annotations = map[string]string{v1alpha1constants.AnnotationSeedCordoned: "true"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+		})
+
+		It("should fail and mention the cordoned seed if it is the only seed in the region", func() {
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(MatchError(ContainSubstring("excluded cordoned seed(s): " + seed.Name)))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should select another seed in the region if one is not cordoned", func() {
+			secondSeed := *seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			secondSeed.Annotations = map[string]string{v1alpha1constants.AnnotationSeedCordoned: "false"}
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&secondSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should consider the cordoned seed if cordoning is not honored", func() {
+			schedulerConfiguration.Schedulers.Shoot.HonorSeedCordoning = false
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - exclude seeds within their scheduling pause window", func() {
+		pauseWindowAnnotations := func(begin, end time.Time) map[string]string {
+			return map[string]string{
+				v1alpha1constants.AnnotationSeedSchedulingPauseWindowBegin: begin.Format("150405-0700"),
+				v1alpha1constants.AnnotationSeedSchedulingPauseWindowEnd:   end.Format("150405-0700"),
+			}
+		}
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.HonorSeedSchedulingPauseWindows = true
+			seed.Annotations = map[string]string{
+				v1alpha1constants.AnnotationSeedSchedulingPauseWindowBegin: "000000+0000",
+				v1alpha1constants.AnnotationSeedSchedulingPauseWindowEnd:   "235959+0000",
+			}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+		})
+
+		It("should fail and mention the paused seed if it is the only seed in the region", func() {
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(MatchError(ContainSubstring("excluded seed(s) within their scheduling pause window: " + seed.Name)))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should select another seed in the region if it is outside of its pause window", func() {
+			now := time.Now()
+			secondSeed := *seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			secondSeed.Annotations = pauseWindowAnnotations(now.Add(2*time.Hour), now.Add(3*time.Hour))
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&secondSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should consider the paused seed if pause windows are not honored", func() {
+			schedulerConfiguration.Schedulers.Shoot.HonorSeedSchedulingPauseWindows = false
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should only exclude seeds whose pause window contains the given time", func() {
+			logger.Logger = logger.NewLogger("")
+			now := time.Date(2019, time.December, 1, 22, 30, 0, 0, time.UTC)
+			pausedSeed := seed.DeepCopy()
+			pausedSeed.Annotations = pauseWindowAnnotations(now.Add(-time.Hour), now.Add(time.Hour))
+			overnightSeed := seed.DeepCopy()
+			overnightSeed.Name = "seed-overnight"
+			overnightSeed.Annotations = pauseWindowAnnotations(now.Add(-time.Hour), now.Add(-2*time.Hour))
+			activeSeed := seed.DeepCopy()
+			activeSeed.Name = "seed-active"
+			activeSeed.Annotations = pauseWindowAnnotations(now.Add(time.Hour), now.Add(2*time.Hour))
+			invalidSeed := seed.DeepCopy()
+			invalidSeed.Name = "seed-invalid"
+			invalidSeed.Annotations = map[string]string{
+				v1alpha1constants.AnnotationSeedSchedulingPauseWindowBegin: "22:00",
+				v1alpha1constants.AnnotationSeedSchedulingPauseWindowEnd:   "23:00",
+			}
+
+			seeds, paused := filterPausedSeeds([]*gardencorev1alpha1.Seed{pausedSeed, overnightSeed, activeSeed, invalidSeed}, now)
+
+			Expect(seeds).To(ConsistOf(activeSeed, invalidSeed))
+			Expect(paused).To(ConsistOf(pausedSeed.Name, overnightSeed.Name))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - prefer seeds of the same organization", func() {
+		var (
+			seedLabel       = "seed.example.com/owner-org"
+			shootAnnotation = "shoot.example.com/preferred-org"
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Annotations = map[string]string{shootAnnotation: "org-b"}
+			seed.Labels = map[string]string{seedLabel: "org-a"}
+			schedulerConfiguration.Schedulers.Shoot.SameOrganizationPreference = &config.SameOrganizationPreferenceConfiguration{
+				SeedLabel:       seedLabel,
+				ShootAnnotation: shootAnnotation,
+			}
+		})
+
+		It("should select the seed of the same organization in case of a tie", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			sameOrgSeed := *seedBase.DeepCopy()
+			sameOrgSeed.Name = "seed-2"
+			sameOrgSeed.Labels = map[string]string{seedLabel: "org-b"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&sameOrgSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(sameOrgSeed.Name))
+		})
+
+		It("should select the less used seed of another organization", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			sameOrgSeed := *seedBase.DeepCopy()
+			sameOrgSeed.Name = "seed-2"
+			sameOrgSeed.Labels = map[string]string{seedLabel: "org-b"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&sameOrgSeed)
+
+			// seed-2 manages more shoots -> the preference must not outweigh the usage
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &sameOrgSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fall back to a seed of another organization if no seed of the same organization is suitable", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			sameOrgSeed := *seedBase.DeepCopy()
+			sameOrgSeed.Name = "seed-2"
+			sameOrgSeed.Labels = map[string]string{seedLabel: "org-b"}
+			sameOrgSeed.Spec.Provider.Region = "asia"
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&sameOrgSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should not prefer any seed if the shoot does not state an organization", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			shoot.Annotations = nil
+
+			sameOrgSeed := *seedBase.DeepCopy()
+			sameOrgSeed.Name = "seed-2"
+			sameOrgSeed.Labels = map[string]string{seedLabel: "org-b"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&sameOrgSeed)
+
+			// seed-2 manages more shoots -> it must not be selected without a preference
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &sameOrgSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - pack shoots by lifetime", func() {
+		var (
+			seedLabel      = "seed.example.com/short-lived"
+			shortLivedSeed gardencorev1alpha1.Seed
+			otherShoot     gardencorev1alpha1.Shoot
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.LifetimePacking = &config.LifetimePackingConfiguration{
+				SeedLabel: seedLabel,
+			}
+
+			shortLivedSeed = *seedBase.DeepCopy()
+			shortLivedSeed.Name = "seed-2"
+			shortLivedSeed.Labels = map[string]string{seedLabel: "true"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&shortLivedSeed)
+
+			otherShoot = *shootBase.DeepCopy()
+			otherShoot.Name = "shoot-2"
+		})
+
+		It("should select the designated seed for an evaluation shoot even if it manages more shoots", func() {
+			shoot.Annotations = map[string]string{"garden.sapcloud.io/purpose": "evaluation"}
+			otherShoot.Spec.SeedName = &shortLivedSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&otherShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(shortLivedSeed.Name))
+		})
+
+		It("should select the designated seed for a shoot with an expiration timestamp", func() {
+			shoot.Annotations = map[string]string{
+				"garden.sapcloud.io/purpose":                   "development",
+				"shoot.garden.sapcloud.io/expirationTimestamp": "2019-12-31T23:59:59Z",
+			}
+			otherShoot.Spec.SeedName = &shortLivedSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&otherShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(shortLivedSeed.Name))
+		})
+
+		It("should keep a production shoot off the designated seed even if it manages fewer shoots", func() {
+			shoot.Annotations = map[string]string{"garden.sapcloud.io/purpose": "production"}
+			otherShoot.Spec.SeedName = &seed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&otherShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fall back to the designated seed for a production shoot if no other seed is suitable", func() {
+			shoot.Annotations = map[string]string{"garden.sapcloud.io/purpose": "production"}
+			seed.Spec.Provider.Region = "asia"
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Update(&seed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(shortLivedSeed.Name))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - prefer seeds advertising the instance families", func() {
+		var familySeed gardencorev1alpha1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Spec.Provider.Workers = []gardencorev1alpha1.Worker{
+				{Name: "worker-a", Machine: gardencorev1alpha1.Machine{Type: "m5.large"}},
+				{Name: "worker-b", Machine: gardencorev1alpha1.Machine{Type: "c5.xlarge"}},
+			}
+			schedulerConfiguration.Schedulers.Shoot.PreferSeedInstanceFamilies = true
+
+			familySeed = *seedBase.DeepCopy()
+			familySeed.Name = "seed-2"
+			familySeed.Labels = map[string]string{
+				"capability.seed.gardener.cloud/instance-family.m5": "true",
+				"capability.seed.gardener.cloud/instance-family.c5": "true",
+			}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&familySeed)
+		})
+
+		It("should select the seed advertising the instance families in case of a tie", func() {
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(familySeed.Name))
+		})
+
+		It("should select a less used seed than the seed advertising the instance families", func() {
+			otherShoot := *shootBase.DeepCopy()
+			otherShoot.Name = "shoot-2"
+			otherShoot.Spec.SeedName = &familySeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&otherShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should not prefer a seed advertising the instance families of only some worker pools", func() {
+			shoot.Spec.Provider.Workers[1].Machine.Type = "r5.large"
+
+			bestSeed, _ := determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &familySeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should not consider a family which is only a prefix of the family of the machine type", func() {
+			shoot.Spec.Provider.Workers = []gardencorev1alpha1.Worker{{Name: "worker", Machine: gardencorev1alpha1.Machine{Type: "m5a.large"}}}
+
+			bestSeed, _ := determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &familySeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should not prefer the seed advertising the instance families if the preference is disabled", func() {
+			schedulerConfiguration.Schedulers.Shoot.PreferSeedInstanceFamilies = false
+
+			bestSeed, _ := determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &familySeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - prefer production-grade seeds", func() {
+		var (
+			seedLabel      = "seed.example.com/tier"
+			productionSeed gardencorev1alpha1.Seed
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Annotations = map[string]string{"garden.sapcloud.io/purpose": "production"}
+			seed.Labels = map[string]string{seedLabel: "standard"}
+			schedulerConfiguration.Schedulers.Shoot.ProductionSeedPreference = &config.ProductionSeedPreferenceConfiguration{
+				SeedLabel:      seedLabel,
+				ProductionTier: "production",
+			}
+
+			productionSeed = *seedBase.DeepCopy()
+			productionSeed.Name = "seed-2"
+			productionSeed.Labels = map[string]string{seedLabel: "production"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&productionSeed)
+		})
+
+		It("should select the production-grade seed for a production shoot in case of a tie", func() {
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(productionSeed.Name))
+		})
+
+		It("should select a less used seed than the production-grade seed", func() {
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &productionSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should not prefer the production-grade seed for a shoot with another purpose", func() {
+			shoot.Annotations = map[string]string{"garden.sapcloud.io/purpose": "development"}
+
+			bestSeed, _ := determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &productionSeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fall back to another seed if no production-grade seed is suitable", func() {
+			productionSeed.Spec.Provider.Region = "asia"
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Update(&productionSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - required seed capabilities", func() {
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/required-seed-capabilities": "foo"}
+		})
+
+		It("should exclude the seed lacking the required capability although it manages the least shoots", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			capableSeed := *seedBase.DeepCopy()
+			capableSeed.Name = "seed-2"
+			capableSeed.Labels = map[string]string{"capability.seed.gardener.cloud/foo": "true"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&capableSeed)
+
+			// capable seed references more shoots -> the other seed would be the best candidate
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &capableSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(capableSeed.Name))
+		})
+
+		It("should fail if no seed advertises the required capability", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - compliance tier", func() {
+		var (
+			seedLabel       = "seed.example.com/compliance-tier"
+			shootAnnotation = "shoot.example.com/compliance-tier"
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Annotations = map[string]string{shootAnnotation: "2"}
+			seed.Labels = map[string]string{seedLabel: "1"}
+			schedulerConfiguration.Schedulers.Shoot.ComplianceTier = &config.ComplianceTierConfiguration{
+				SeedLabel:       seedLabel,
+				ShootAnnotation: shootAnnotation,
+			}
+		})
+
+		It("should only consider the compliant seed although it manages more shoots", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			compliantSeed := *seedBase.DeepCopy()
+			compliantSeed.Name = "seed-2"
+			compliantSeed.Labels = map[string]string{seedLabel: "3"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&compliantSeed)
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &compliantSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(compliantSeed.Name))
+		})
+
+		It("should fail if no seed has a sufficient compliance tier", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			unlabeledSeed := *seedBase.DeepCopy()
+			unlabeledSeed.Name = "seed-2"
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&unlabeledSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(MatchError(ContainSubstring("none have a compliance tier of at least 2")))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should not filter seeds if the shoot does not request a compliance tier", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			shoot.Annotations = nil
+
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - data residency", func() {
+		var (
+			seedLabel    = "seed.example.com/jurisdiction"
+			jurisdiction = "de"
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Spec.DataResidency = &jurisdiction
+			seed.Labels = map[string]string{seedLabel: "fr"}
+			schedulerConfiguration.Schedulers.Shoot.DataResidency = &config.DataResidencyConfiguration{
+				SeedLabel: seedLabel,
+			}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+		})
+
+		It("should only consider the seed in the required jurisdiction although it manages more shoots", func() {
+			residentSeed := *seedBase.DeepCopy()
+			residentSeed.Name = "seed-2"
+			residentSeed.Labels = map[string]string{seedLabel: "de"}
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&residentSeed)
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &residentSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(residentSeed.Name))
+		})
+
+		It("should fail if no seed is located in the required jurisdiction", func() {
+			unlabeledSeed := *seedBase.DeepCopy()
+			unlabeledSeed.Name = "seed-2"
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&unlabeledSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(MatchError(ContainSubstring(`found 2 possible seed cluster(s), however none are located in the jurisdiction "de" required by the data residency of the shoot`)))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should fail if the jurisdiction of the seeds is not configured", func() {
+			seed.Labels = map[string]string{seedLabel: jurisdiction}
+			schedulerConfiguration.Schedulers.Shoot.DataResidency = nil
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(MatchError(ContainSubstring(`the data residency of the shoot requires the jurisdiction "de", however the jurisdiction of the seeds is not configured`)))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should not filter seeds if the shoot does not require a jurisdiction", func() {
+			shoot.Spec.DataResidency = nil
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - prefer the previous seed", func() {
+		var previousSeed gardencorev1alpha1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Annotations = map[string]string{"scheduler.gardener.cloud/previous-seed": "seed-2"}
+			schedulerConfiguration.Schedulers.Shoot.PreferPreviousSeed = true
+
+			previousSeed = *seedBase.DeepCopy()
+			previousSeed.Name = "seed-2"
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+		})
+
+		It("should select the previous seed in case of a tie", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&previousSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(previousSeed.Name))
+		})
+
+		It("should select a less used seed than the previous seed", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&previousSeed)
+
+			// the previous seed manages more shoots -> the preference must not outweigh the usage
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &previousSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should select another seed if the previous seed is not suitable anymore", func() {
+			previousSeed.Spec.Provider.Region = "asia"
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&previousSeed)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should record the seed the shoot is scheduled to", func() {
+			decision := newSchedulingDecision(time.Now(), config.SameRegion, []*gardencorev1alpha1.Seed{&seed}, &seed)
+
+			Expect(setScheduledSeed(&shoot, seed.Name, decision, schedulerConfiguration.Schedulers.Shoot)).To(Succeed())
+
+			Expect(*shoot.Spec.SeedName).To(Equal(seed.Name))
+			Expect(shoot.Annotations).To(HaveKeyWithValue("scheduler.gardener.cloud/previous-seed", seed.Name))
+		})
+
+		It("should not prefer the previous seed if the preference is disabled", func() {
+			schedulerConfiguration.Schedulers.Shoot.PreferPreviousSeed = false
+
+			bestSeed, _ := determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &previousSeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - balance by worker node count", func() {
+		var otherSeed gardencorev1alpha1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+
+			otherSeed = *seedBase.DeepCopy()
+			otherSeed.Name = "seed-2"
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&otherSeed)
+
+			// the first seed manages a single large shoot, the other seed manages two small shoots
+			largeShoot := *shootBase.DeepCopy()
+			largeShoot.Name = "large"
+			largeShoot.Spec.SeedName = &seed.Name
+			largeShoot.Spec.Provider.Workers = []gardencorev1alpha1.Worker{{Name: "worker", Maximum: 300}}
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&largeShoot)
+
+			for _, name := range []string{"small-1", "small-2"} {
+				smallShoot := *shootBase.DeepCopy()
+				smallShoot.Name = name
+				smallShoot.Spec.SeedName = &otherSeed.Name
+				smallShoot.Spec.Provider.Workers = []gardencorev1alpha1.Worker{{Name: "worker-a", Maximum: 1}, {Name: "worker-b", Maximum: 2}}
+				gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&smallShoot)
+			}
+		})
+
+		It("should select the seed managing the fewest shoots when balancing by shoot count", func() {
+			schedulerConfiguration.Schedulers.Shoot.BalancingStrategy = config.BalanceByShootCount
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should balance by shoot count if no balancing strategy is configured", func() {
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should select the seed managing the fewest worker nodes when balancing by node count", func() {
+			schedulerConfiguration.Schedulers.Shoot.BalancingStrategy = config.BalanceByNodeCount
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(otherSeed.Name))
+		})
+	})
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - prefer seeds close to the registry", func() {
+		var (
+			closeSeed   gardencorev1alpha1.Seed
+			distantSeed gardencorev1alpha1.Seed
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			seed = *seedBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.RegistryProximity = &config.RegistryProximityConfiguration{
+				SeedLabel: "seed.example.com/registry-proximity",
+			}
+
+			seed.Labels = map[string]string{"seed.example.com/registry-proximity": "invalid"}
+			distantSeed = *seedBase.DeepCopy()
+			distantSeed.Name = "seed-2"
+			distantSeed.Labels = map[string]string{"seed.example.com/registry-proximity": "1"}
+			closeSeed = *seedBase.DeepCopy()
+			closeSeed.Name = "seed-3"
+			closeSeed.Labels = map[string]string{"seed.example.com/registry-proximity": "10"}
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&distantSeed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&closeSeed)
+		})
+
+		It("should select the seed with the highest proximity among equally used seeds", func() {
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(closeSeed.Name))
+		})
+
+		It("should prefer a less used seed over a seed with a higher proximity", func() {
+			secondShoot := *shootBase.DeepCopy()
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &closeSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(distantSeed.Name))
+		})
+
+		It("should not consider the proximity if it is not configured", func() {
+			schedulerConfiguration.Schedulers.Shoot.RegistryProximity = nil
+
+			bestSeed, _ := determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &distantSeed, &closeSeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+	})
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - spread the shoots of a project across regions", func() {
+		var (
+			northSeed gardencorev1alpha1.Seed
+			westSeed  gardencorev1alpha1.Seed
+			usedSeed  gardencorev1alpha1.Seed
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			shoot.Spec.Region = "europe-central1"
+			schedulerConfiguration.Schedulers.Shoot.Strategy = config.MinimalDistance
+			schedulerConfiguration.Schedulers.Shoot.SpreadProjectShootsAcrossRegions = true
+
+			northSeed = *seedBase.DeepCopy()
+			northSeed.Name = "seed-north"
+			northSeed.Spec.Provider.Region = "europe-north1"
+			westSeed = *seedBase.DeepCopy()
+			westSeed.Name = "seed-west"
+			westSeed.Spec.Provider.Region = "europe-west1"
+			// the used seed shares the region with the north seed
+			usedSeed = *seedBase.DeepCopy()
+			usedSeed.Name = "seed-north-used"
+			usedSeed.Spec.Provider.Region = "europe-north1"
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&northSeed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&westSeed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&usedSeed)
+		})
+
+		It("should select the seed in the region hosting fewer shoots of the project among equally used seeds", func() {
+			projectShoot := *shootBase.DeepCopy()
+			projectShoot.Name = "shoot-2"
+			projectShoot.Spec.SeedName = &usedSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&projectShoot)
+
+			bestSeed, _, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(westSeed.Name))
+		})
+
+		It("should not consider the shoots of other projects", func() {
+			otherProjectShoot := *shootBase.DeepCopy()
+			otherProjectShoot.Name = "shoot-2"
+			otherProjectShoot.Namespace = "other-namespace"
+			otherProjectShoot.Spec.SeedName = &usedSeed.Name
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(&otherProjectShoot)
+
+			seedList, err := gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister().List(labels.Everything())
+			Expect(err).NotTo(HaveOccurred())
+			shootList, err := gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister().List(labels.Everything())
+			Expect(err).NotTo(HaveOccurred())
+
+			bestSeed, _ := determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&northSeed, &westSeed, &usedSeed}, seedList, shootList, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(bestSeed.Name).To(Equal(northSeed.Name))
+		})
+	})
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - no seeds registered", func() {
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+		})
+
+		It("should fail fast if no seeds are registered at all", func() {
+			bestSeed, candidates, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot, nil, nil)
+
+			Expect(err).To(Equal(ErrNoSeedsRegistered))
+			Expect(bestSeed).To(BeNil())
+			Expect(candidates).To(BeEmpty())
+		})
+
+		It("should count successful scheduling decisions by the configured strategy", func() {
+			schedulerConfiguration.Schedulers.Shoot.Strategy = config.MinimalDistance
+
+			var (
+				recorder = record.NewFakeRecorder(1)
+				control  = &defaultControl{recorder: recorder}
+				strategy = schedulerConfiguration.Schedulers.Shoot.Strategy
+				counter  = func() float64 {
+					metric := &dto.Metric{}
+					Expect(shootsScheduled.With(prometheus.Labels{"strategy": string(strategy)}).Write(metric)).To(Succeed())
+					return metric.GetCounter().GetValue()
+				}
+				before = counter()
+			)
+
+			control.reportSuccessfulScheduling(&shoot, newSchedulingDecision(time.Now(), strategy, []*gardencorev1alpha1.Seed{&seed}, &seed))
+
+			Expect(strategy).To(Equal(config.MinimalDistance))
+			Expect(counter()).To(Equal(before + 1))
+			Expect(recorder.Events).To(Receive(HavePrefix("Normal SchedulingSuccessful")))
+		})
+
+		It("should report a distinct event reason if no seeds are registered at all", func() {
+			var (
+				recorder = record.NewFakeRecorder(1)
+				control  = &defaultControl{recorder: recorder}
+			)
+
+			control.reportFailedScheduling(&shoot, ErrNoSeedsRegistered)
+
+			Expect(recorder.Events).To(Receive(HavePrefix("Warning NoSeedsRegistered")))
+		})
+	})
+	Context("SEED DETERMINATION - log the scores of the candidates", func() {
+		var (
+			output    *bytes.Buffer
+			otherSeed gardencorev1alpha1.Seed
+		)
+
+		BeforeEach(func() {
+			output = &bytes.Buffer{}
+			logger.Logger = logger.AddWriter(logger.NewLogger("debug"), output)
+
+			shoot = *shootBase.DeepCopy()
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			schedulerConfiguration.Schedulers.Shoot.LogCandidateScores = true
+			schedulerConfiguration.Schedulers.Shoot.RegistryProximity = &config.RegistryProximityConfiguration{
+				SeedLabel: "seed.example.com/registry-proximity",
+			}
+
+			seed = *seedBase.DeepCopy()
+			seed.Labels = map[string]string{"seed.example.com/registry-proximity": "5"}
+			otherSeed = *seedBase.DeepCopy()
+			otherSeed.Name = "seed-2"
+			otherSeed.Spec.Provider.Region = "eu-west"
+		})
+
+		AfterEach(func() {
+			logger.Logger = logger.NewLogger("")
+		})
+
+		It("should log the score of every candidate at debug level", func() {
+			usingShoot := *shootBase.DeepCopy()
+			usingShoot.Name = "shoot-2"
+			usingShoot.Spec.SeedName = &otherSeed.Name
+
+			determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &otherSeed}, nil, []*gardencorev1alpha1.Shoot{&usingShoot}, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(output.String()).To(ContainSubstring(`candidate seed 'seed-1': usage=0 regionDistance=0 projectRegionUsage=0 registryProximity=5`))
+			Expect(output.String()).To(ContainSubstring(`candidate seed 'seed-2': usage=1 regionDistance=4 projectRegionUsage=0 registryProximity=0`))
+		})
+
+		It("should not log the scores if the log level is not debug", func() {
+			logger.Logger = logger.AddWriter(logger.NewLogger("info"), output)
+
+			determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &otherSeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(output.String()).To(BeEmpty())
+		})
+
+		It("should not log the scores if it is not configured", func() {
+			schedulerConfiguration.Schedulers.Shoot.LogCandidateScores = false
+
+			determineLeastUsedSeed(&shoot, []*gardencorev1alpha1.Seed{&seed, &otherSeed}, nil, nil, schedulerConfiguration.Schedulers.Shoot)
+
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+	Context("Benchmark", func() {
+		It("should complete the seed determination for a large synthetic fleet", func() {
+			var (
+				seeds  []*gardencorev1alpha1.Seed
+				shoots []*gardencorev1alpha1.Shoot
+			)
+
+			for i := 0; i < 100; i++ {
+				s := seedBase.DeepCopy()
+				s.Name = fmt.Sprintf("seed-%d", i)
+				seeds = append(seeds, s)
+			}
+
+			for i := 0; i < 1000; i++ {
+				s := shootBase.DeepCopy()
+				s.Name = fmt.Sprintf("shoot-%d", i)
+				if i%2 == 0 {
+					s.Spec.SeedName = &seeds[i%len(seeds)].Name
+				}
+				shoots = append(shoots, s)
+			}
+
+			_, p95 := benchmarkScheduling(seeds, shoots, config.SameRegion)
+
+			Expect(p95).To(BeNumerically("<", time.Second))
+		})
+
+		It("should return zero durations if no shoots are given", func() {
+			avg, p95 := benchmarkScheduling([]*gardencorev1alpha1.Seed{seedBase.DeepCopy()}, nil, config.SameRegion)
+
+			Expect(avg).To(BeZero())
+			Expect(p95).To(BeZero())
+		})
+
+		It("should compute the average and the 95th percentile of the durations", func() {
+			durations := make([]time.Duration, 0, 100)
+			for i := 100; i > 0; i-- {
+				durations = append(durations, time.Duration(i)*time.Millisecond)
+			}
+
+			avg, p95 := schedulingLatencies(durations)
+
+			Expect(avg).To(Equal(50500 * time.Microsecond))
+			Expect(p95).To(Equal(95 * time.Millisecond))
+		})
+
+		It("should not cap the average at the 95th percentile", func() {
+			durations := []time.Duration{time.Hour}
+			for i := 0; i < 99; i++ {
+				durations = append(durations, time.Millisecond)
+			}
+
+			avg, p95 := schedulingLatencies(durations)
+
+			Expect(p95).To(Equal(time.Millisecond))
+			Expect(avg).To(BeNumerically(">", p95))
+		})
+	})
+
+	Context("Scheduling rate limit", func() {
+		It("should not limit the scheduling throughput if no rate limit is configured", func() {
+			Expect(newSchedulingRateLimiter(nil)).To(BeNil())
+		})
+
+		It("should respect the configured rate over a burst of shoots", func() {
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			shoot := shootBase.DeepCopy()
+			gardenCoreInformerFactory.Core().V1alpha1().Shoots().Informer().GetStore().Add(shoot)
+
+			var (
+				control    = &countingControl{}
+				controller = &SchedulerController{
+					control:               control,
+					shootLister:           gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(),
+					schedulingRateLimiter: newSchedulingRateLimiter(&config.SchedulingRateLimitConfiguration{QPS: 20, Burst: 2}),
+				}
+				key   = shoot.Namespace + "/" + shoot.Name
+				start = time.Now()
+			)
+
+			for i := 0; i < 6; i++ {
+				Expect(controller.reconcileShootKey(context.TODO(), key)).To(Succeed())
+			}
+
+			// the first two schedulings consume the burst, the remaining four have to wait for 50ms each
+			Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond-10*time.Millisecond))
+			Expect(control.scheduled).To(Equal(6))
+		})
+	})
+
+	Context("Scheduling decision audit", func() {
+		var (
+			now      = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+			seedA    = &gardencorev1alpha1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-a"}}
+			seedB    = &gardencorev1alpha1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-b"}}
+			decision = newSchedulingDecision(now, config.SameRegion, []*gardencorev1alpha1.Seed{seedA, seedB}, seedB)
+		)
+
+		BeforeEach(func() {
+			logger.Logger = logger.NewLogger("")
+		})
+
+		decodeHistory := func(shoot *gardencorev1alpha1.Shoot) []SchedulingDecision {
+			var history []SchedulingDecision
+			Expect(json.Unmarshal([]byte(shoot.Annotations[v1alpha1constants.AnnotationShootSchedulingDecisions]), &history)).To(Succeed())
+			return history
+		}
+
+		It("should record the timestamp, strategy, candidates and winner of the decision", func() {
+			shoot := shootBase.DeepCopy()
+
+			Expect(recordSchedulingDecision(shoot, decision, 5)).To(Succeed())
+
+			history := decodeHistory(shoot)
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].Timestamp.Time).To(BeTemporally("==", now))
+			Expect(history[0].Strategy).To(Equal(config.SameRegion))
+			Expect(history[0].Candidates).To(Equal([]string{"seed-a", "seed-b"}))
+			Expect(history[0].Seed).To(Equal("seed-b"))
+			Expect(shoot.Annotations[v1alpha1constants.AnnotationShootSchedulingDecisions]).To(Equal(`[{"timestamp":"2019-10-01T12:00:00Z","strategy":"SameRegion","candidates":["seed-a","seed-b"],"seed":"seed-b"}]`))
+		})
+
+		It("should only keep the most recent decisions", func() {
+			shoot := shootBase.DeepCopy()
+
+			for i := 0; i < 3; i++ {
+				d := newSchedulingDecision(now.Add(time.Duration(i)*time.Minute), config.SameRegion, []*gardencorev1alpha1.Seed{seedA}, seedA)
+				Expect(recordSchedulingDecision(shoot, d, 2)).To(Succeed())
+			}
+
+			history := decodeHistory(shoot)
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].Timestamp.Time).To(BeTemporally("==", now.Add(time.Minute)))
+			Expect(history[1].Timestamp.Time).To(BeTemporally("==", now.Add(2*time.Minute)))
+		})
+
+		It("should replace an undecodable history", func() {
+			shoot := shootBase.DeepCopy()
+			shoot.Annotations = map[string]string{v1alpha1constants.AnnotationShootSchedulingDecisions: "foo"}
+
+			Expect(recordSchedulingDecision(shoot, decision, 5)).To(Succeed())
+
+			Expect(decodeHistory(shoot)).To(HaveLen(1))
+		})
+
+		It("should return the candidates the seed was chosen from", func() {
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			cloudProfile := cloudProfileBase.DeepCopy()
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(cloudProfile)
+
+			seed := seedBase.DeepCopy()
+			secondSeed := seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(seed)
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(secondSeed)
+
+			shoot := shootBase.DeepCopy()
+			bestSeed, candidates, err := determineSeed(shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfigurationBase.Schedulers.Shoot, nil, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(candidates).To(ConsistOf(seed, secondSeed))
+			Expect(candidates).To(ContainElement(bestSeed))
+		})
+	})
+
+	Context("Fair queuing", func() {
+		var (
+			queue     *fairQueue
+			pendingOf = func(projects ...string) func() int {
+				return func() int {
+					queue.lock.Lock()
+					defer queue.lock.Unlock()
+
+					n := 0
+					for _, project := range projects {
+						n += len(queue.pending[project])
+					}
+					return n
+				}
+			}
+			getKeys = func(n int) []interface{} {
+				var keys []interface{}
+				for i := 0; i < n; i++ {
+					key, quit := queue.Get()
+					Expect(quit).To(BeFalse())
+					keys = append(keys, key)
+					queue.Done(key)
+				}
+				return keys
+			}
+		)
+
+		AfterEach(func() {
+			if !queue.ShuttingDown() {
+				queue.ShutDown()
+			}
+		})
+
+		It("should interleave the shoots of different projects", func() {
+			rateLimitingQueue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			for _, key := range []string{"garden-a/1", "garden-a/2", "garden-a/3", "garden-a/4", "garden-b/1", "garden-b/2"} {
+				rateLimitingQueue.Add(key)
+			}
+			queue = newFairQueue(rateLimitingQueue)
+			Eventually(pendingOf("garden-a", "garden-b")).Should(Equal(6))
+
+			// The shoots of project b are not delayed until all shoots of project a have been scheduled.
+			Expect(getKeys(4)).To(Equal([]interface{}{"garden-a/1", "garden-b/1", "garden-a/2", "garden-b/2"}))
+			Expect(getKeys(2)).To(Equal([]interface{}{"garden-a/3", "garden-a/4"}))
+			Expect(queue.Len()).To(BeZero())
+		})
+
+		It("should include projects whose shoots are added later in the rotation", func() {
+			queue = newFairQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()))
+			queue.Add("garden-a/1")
+			queue.Add("garden-a/2")
+			queue.Add("garden-a/3")
+			Eventually(pendingOf("garden-a")).Should(Equal(3))
+
+			Expect(getKeys(1)).To(Equal([]interface{}{"garden-a/1"}))
+
+			queue.Add("garden-b/1")
+			Eventually(pendingOf("garden-b")).Should(Equal(1))
+
+			Expect(getKeys(3)).To(Equal([]interface{}{"garden-b/1", "garden-a/2", "garden-a/3"}))
+		})
+
+		It("should signal the shutdown once all shoots have been handed out", func() {
+			queue = newFairQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()))
+			queue.Add("garden-a/1")
+			Eventually(pendingOf("garden-a")).Should(Equal(1))
+			queue.ShutDown()
+
+			Expect(getKeys(1)).To(Equal([]interface{}{"garden-a/1"}))
+			_, quit := queue.Get()
+			Expect(quit).To(BeTrue())
+		})
+	})
+
+	Context("Scheduling decision trace", func() {
+		var (
+			now   = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+			seedA = &gardencorev1alpha1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-a"}}
+			seedB = &gardencorev1alpha1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-b"}}
+		)
+
+		It("should record the shoot, candidates with their usage, winner and reason of the decision", func() {
+			decision := newTracedSchedulingDecision(now, &shootBase, []*gardencorev1alpha1.Seed{seedA, seedB}, map[string]int{"seed-a": 3, "seed-b": 1}, seedB, config.SameRegion, nil)
+
+			Expect(decision.Timestamp.Time).To(BeTemporally("==", now))
+			Expect(decision.Shoot).To(Equal(fmt.Sprintf("%s/%s", shootBase.Namespace, shootBase.Name)))
+			Expect(decision.Candidates).To(Equal([]TracedCandidate{{Name: "seed-a", Usage: 3}, {Name: "seed-b", Usage: 1}}))
+			Expect(decision.Seed).To(Equal("seed-b"))
+			Expect(decision.Reason).To(Equal("least used of 2 candidate(s) determined with SameRegion strategy"))
+		})
+
+		It("should record the error as reason of a failed decision", func() {
+			decision := newTracedSchedulingDecision(now, &shootBase, nil, nil, nil, config.SameRegion, ErrNoSeedsRegistered)
+
+			Expect(decision.Candidates).To(BeEmpty())
+			Expect(decision.Seed).To(BeEmpty())
+			Expect(decision.Reason).To(Equal(ErrNoSeedsRegistered.Error()))
+		})
+
+		It("should only keep the most recent decisions, the oldest first", func() {
+			trace := NewDecisionTrace(&config.SchedulingDecisionTraceConfiguration{MaxRecords: 2})
+
+			for i := 0; i < 5; i++ {
+				trace.record(newTracedSchedulingDecision(now.Add(time.Duration(i)*time.Minute), &shootBase, []*gardencorev1alpha1.Seed{seedA}, nil, seedA, config.SameRegion, nil))
+			}
+
+			decisions := trace.Decisions()
+			Expect(decisions).To(HaveLen(2))
+			Expect(decisions[0].Timestamp.Time).To(BeTemporally("==", now.Add(3*time.Minute)))
+			Expect(decisions[1].Timestamp.Time).To(BeTemporally("==", now.Add(4*time.Minute)))
+		})
+
+		It("should serve the decisions JSON-encoded", func() {
+			trace := NewDecisionTrace(&config.SchedulingDecisionTraceConfiguration{MaxRecords: 2})
+			trace.record(newTracedSchedulingDecision(now, &shootBase, []*gardencorev1alpha1.Seed{seedA}, map[string]int{"seed-a": 1}, seedA, config.SameRegion, nil))
+
+			recorder := httptest.NewRecorder()
+			trace.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, DecisionTracePath, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(recorder.Body.String()).To(Equal(fmt.Sprintf(`[{"timestamp":"2019-10-01T12:00:00Z","shoot":"%s/%s","candidates":[{"name":"seed-a","usage":1}],"seed":"seed-a","reason":"least used of 1 candidate(s) determined with SameRegion strategy"}]`, shootBase.Namespace, shootBase.Name)))
+		})
+
+		It("should not trace decisions if not configured", func() {
+			trace := NewDecisionTrace(nil)
+
+			Expect(trace).To(BeNil())
+			Expect(func() {
+				trace.record(newTracedSchedulingDecision(now, &shootBase, nil, nil, nil, config.SameRegion, ErrNoSeedsRegistered))
+			}).NotTo(Panic())
+		})
+	})
+
+	Context("Unschedulable shoots", func() {
+		var (
+			gauge   prometheus.Gauge
+			tracker *unschedulableShootTracker
+			control *defaultControl
+
+			value = func() float64 {
+				metric := &dto.Metric{}
+				Expect(gauge.Write(metric)).To(Succeed())
+				return metric.GetGauge().GetValue()
+			}
+		)
+
+		BeforeEach(func() {
+			logger.Logger = logger.NewLogger("")
+			shoot = *shootBase.DeepCopy()
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+			gauge = prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_unschedulable_shoots"})
+			tracker = newUnschedulableShootTracker(gauge)
+			control = &defaultControl{
+				recorder:            record.NewFakeRecorder(10),
+				config:              &schedulerConfiguration,
+				shootLister:         gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(),
+				seedLister:          gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(),
+				cloudProfileLister:  gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(),
+				unschedulableShoots: tracker,
+			}
+		})
+
+		It("should rise once per shoot failing to be scheduled and fall once the shoots leave the unschedulable state", func() {
+			otherShoot := shoot.DeepCopy()
+			otherShoot.Name = "shoot-2"
+
+			var (
+				key      = fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)
+				otherKey = fmt.Sprintf("%s/%s", otherShoot.Namespace, otherShoot.Name)
+			)
+
+			Expect(control.ScheduleShoot(context.TODO(), &shoot, key)).To(Equal(ErrNoSeedsRegistered))
+			Expect(value()).To(Equal(1.0))
+			Expect(control.ScheduleShoot(context.TODO(), &shoot, key)).To(Equal(ErrNoSeedsRegistered))
+			Expect(value()).To(Equal(1.0))
+			Expect(control.ScheduleShoot(context.TODO(), otherShoot, otherKey)).To(Equal(ErrNoSeedsRegistered))
+			Expect(value()).To(Equal(2.0))
+
+			controller := &SchedulerController{unschedulableShoots: tracker}
+
+			// the shoot was scheduled (e.g. by an operator)
+			shoot.Spec.SeedName = makeStrPtr("seed")
+			controller.shootUpdate(otherShoot, &shoot)
+			Expect(value()).To(Equal(1.0))
+
+			controller.shootDelete(otherShoot)
+			Expect(value()).To(Equal(0.0))
+		})
+
+		It("should ignore shoots which are not tracked", func() {
+			tracker.forget(fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+
+			Expect(value()).To(Equal(0.0))
+		})
+	})
+
+	Context("Scheduling", func() {
+		var (
+			shoot = shootBase.DeepCopy()
+			seed  = *seedBase.DeepCopy()
+		)
+
+		It("should request the scheduling of the shoot to the seed", func() {
+			var runtimeClient = mockclient.NewMockClient(ctrl)
+
+			shoot.Spec.SeedName = &seed.Name
+			runtimeClient.EXPECT().Update(context.TODO(), shoot).DoAndReturn(func(ctx context.Context, list runtime.Object) error {
+				return nil
+			})
+
+			executeSchedulingRequest := func(ctx context.Context, shoot *gardencorev1alpha1.Shoot) error {
+				return runtimeClient.Update(ctx, shoot)
+			}
+
+			err := UpdateShootToBeScheduledOntoSeed(context.TODO(), shoot, &seed, executeSchedulingRequest)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
+
+func makeStrPtr(v string) *string {
+	c := string(v)
+	return &c
+}
+
+// countingControl is a SchedulerInterface which only counts the requested schedulings.
+type countingControl struct {
+	scheduled int
+}
+
+func (c *countingControl) ScheduleShoot(_ context.Context, _ *gardencorev1alpha1.Shoot, _ string) error {
+	c.scheduled++
+	return nil
 }
@@ -0,0 +1,81 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"encoding/json"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SchedulingDecision is the record of a scheduling decision taken for a shoot. The history of the decisions is stored
+// JSON-encoded in the `scheduler.gardener.cloud/scheduling-decisions` annotation of the shoot.
+type SchedulingDecision struct {
+	// Timestamp is the time when the decision was taken.
+	Timestamp metav1.Time `json:"timestamp"`
+	// Strategy is the seed determination strategy used for the decision.
+	Strategy config.CandidateDeterminationStrategy `json:"strategy"`
+	// Candidates are the names of the seeds the shoot could have been scheduled to.
+	Candidates []string `json:"candidates"`
+	// Seed is the name of the seed the shoot has been scheduled to.
+	Seed string `json:"seed"`
+}
+
+func newSchedulingDecision(now time.Time, strategy config.CandidateDeterminationStrategy, candidates []*gardencorev1alpha1.Seed, seed *gardencorev1alpha1.Seed) SchedulingDecision {
+	decision := SchedulingDecision{
+		Timestamp:  metav1.NewTime(now),
+		Strategy:   strategy,
+		Candidates: make([]string, 0, len(candidates)),
+		Seed:       seed.Name,
+	}
+
+	for _, candidate := range candidates {
+		decision.Candidates = append(decision.Candidates, candidate.Name)
+	}
+
+	return decision
+}
+
+// recordSchedulingDecision appends the given decision to the history of scheduling decisions of the shoot. Only the
+// <maxRecords> most recent decisions are kept. An undecodable history is replaced.
+func recordSchedulingDecision(shoot *gardencorev1alpha1.Shoot, decision SchedulingDecision, maxRecords int) error {
+	var history []SchedulingDecision
+
+	if value, ok := shoot.Annotations[v1alpha1constants.AnnotationShootSchedulingDecisions]; ok {
+		if err := json.Unmarshal([]byte(value), &history); err != nil {
+			logger.Logger.Warnf("Replacing undecodable scheduling decisions of shoot '%s/%s': %v", shoot.Namespace, shoot.Name, err)
+			history = nil
+		}
+	}
+
+	history = append(history, decision)
+	if len(history) > maxRecords {
+		history = history[len(history)-maxRecords:]
+	}
+
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1alpha1constants.AnnotationShootSchedulingDecisions, string(data))
+	return nil
+}
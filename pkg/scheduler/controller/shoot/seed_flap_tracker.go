@@ -0,0 +1,104 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// seedFlapTracker keeps track of the recent transitions of the `SeedAvailable` condition of seeds.
+type seedFlapTracker struct {
+	lock        sync.Mutex
+	transitions map[string][]time.Time
+}
+
+func newSeedFlapTracker() *seedFlapTracker {
+	return &seedFlapTracker{
+		transitions: map[string][]time.Time{},
+	}
+}
+
+// observe records a transition of the `SeedAvailable` condition if its status differs between the old and the new
+// version of the seed. Nothing is recorded if the flap detection is not configured, and transitions older than the
+// configured window are discarded, so that the history does not grow unbounded.
+func (t *seedFlapTracker) observe(oldSeed, newSeed *gardencorev1alpha1.Seed, flapDetection *config.SeedFlapDetectionConfiguration, now time.Time) {
+	if flapDetection == nil {
+		return
+	}
+
+	var (
+		oldCondition = gardencorev1alpha1helper.GetCondition(oldSeed.Status.Conditions, gardencorev1alpha1.SeedAvailable)
+		newCondition = gardencorev1alpha1helper.GetCondition(newSeed.Status.Conditions, gardencorev1alpha1.SeedAvailable)
+	)
+
+	if oldCondition == nil || newCondition == nil || oldCondition.Status == newCondition.Status {
+		return
+	}
+
+	transitionTime := newCondition.LastTransitionTime.Time
+	if transitionTime.IsZero() {
+		transitionTime = now
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.prune(newSeed.Name, append(t.transitions[newSeed.Name], transitionTime), now.Add(-flapDetection.Window.Duration))
+}
+
+// forget removes all recorded transitions of the seed with the given name.
+func (t *seedFlapTracker) forget(seedName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.transitions, seedName)
+}
+
+// isFlapping returns true if the seed with the given name had more transitions of its `SeedAvailable` condition
+// within the configured window than allowed. Transitions older than the window are discarded.
+func (t *seedFlapTracker) isFlapping(seedName string, flapDetection *config.SeedFlapDetectionConfiguration, now time.Time) bool {
+	if t == nil || flapDetection == nil {
+		return false
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.prune(seedName, t.transitions[seedName], now.Add(-flapDetection.Window.Duration)) > flapDetection.MaxTransitions
+}
+
+// prune stores the given transitions of the seed with the given name which happened after the given window start and
+// returns their number. The caller must hold the lock.
+func (t *seedFlapTracker) prune(seedName string, transitions []time.Time, windowStart time.Time) int {
+	var recent []time.Time
+	for _, transitionTime := range transitions {
+		if transitionTime.After(windowStart) {
+			recent = append(recent, transitionTime)
+		}
+	}
+
+	if len(recent) == 0 {
+		delete(t.transitions, seedName)
+		return 0
+	}
+
+	t.transitions[seedName] = recent
+	return len(recent)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// unschedulableShootTracker keeps track of the shoots without a seed which failed to be scheduled at least once and
+// reflects their number in a gauge.
+type unschedulableShootTracker struct {
+	lock  sync.Mutex
+	keys  sets.String
+	gauge prometheus.Gauge
+}
+
+func newUnschedulableShootTracker(gauge prometheus.Gauge) *unschedulableShootTracker {
+	return &unschedulableShootTracker{
+		keys:  sets.NewString(),
+		gauge: gauge,
+	}
+}
+
+// markUnschedulable records that the shoot with the given key failed to be scheduled.
+func (t *unschedulableShootTracker) markUnschedulable(key string) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.keys.Insert(key)
+	t.gauge.Set(float64(t.keys.Len()))
+}
+
+// forget removes the shoot with the given key, e.g. because it was scheduled or deleted.
+func (t *unschedulableShootTracker) forget(key string) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.keys.Delete(key)
+	t.gauge.Set(float64(t.keys.Len()))
+}
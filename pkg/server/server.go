@@ -68,8 +68,9 @@ func ServeHTTPS(ctx context.Context, k8sGardenInformers gardeninformers.SharedIn
 	logger.Logger.Info("HTTPS server stopped.")
 }
 
-// ServeHTTP starts a HTTP server.
-func ServeHTTP(ctx context.Context, serverHTTPPort int, serverHTTPBindAddress string) {
+// ServeHTTP starts a HTTP server. The given handler functions are served in addition to the metrics and health
+// endpoints.
+func ServeHTTP(ctx context.Context, httpHandlerFunctions map[string]func(http.ResponseWriter, *http.Request), serverHTTPPort int, serverHTTPBindAddress string) {
 	var (
 		listenAddressHTTP = fmt.Sprintf("%s:%d", serverHTTPBindAddress, serverHTTPPort)
 		serverMuxHTTP     = http.NewServeMux()
@@ -79,6 +80,9 @@ func ServeHTTP(ctx context.Context, serverHTTPPort int, serverHTTPBindAddress st
 	// Add handlers to HTTP server and start it.
 	serverMuxHTTP.Handle("/metrics", promhttp.Handler())
 	serverMuxHTTP.HandleFunc("/healthz", handlers.Healthz)
+	for pattern, handlerFunc := range httpHandlerFunctions {
+		serverMuxHTTP.HandleFunc(pattern, handlerFunc)
+	}
 
 	go func() {
 		logger.Logger.Infof("Starting HTTP server on %s", listenAddressHTTP)
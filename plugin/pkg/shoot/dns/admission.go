@@ -141,9 +141,43 @@ func (d *DNS) Admit(a admission.Attributes, o admission.ObjectInterfaces) error
 		return apierrors.NewBadRequest(fmt.Sprintf("shoot domain field .spec.dns.domain must be set if provider != %s", garden.DNSUnmanaged))
 	}
 
+	// Records for a domain which is not a default domain can only be created by a provider configured in the Shoot.
+	if len(shoot.Spec.DNS.Providers) == 0 {
+		defaultDomain, err := isDefaultDomain(*shoot.Spec.DNS.Domain, d.secretLister)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if !defaultDomain {
+			return apierrors.NewBadRequest(fmt.Sprintf("shoot domain field .spec.dns.providers must contain at least one provider if .spec.dns.domain is set to a non-default domain (domain: %s)", *shoot.Spec.DNS.Domain))
+		}
+	}
+
 	return nil
 }
 
+// isDefaultDomain checks whether the given domain is a default domain or a subdomain of one.
+func isDefaultDomain(domain string, secretLister kubecorev1listers.SecretLister) (bool, error) {
+	selector, err := labels.Parse(fmt.Sprintf("%s=%s", common.GardenRole, common.GardenRoleDefaultDomain))
+	if err != nil {
+		return false, err
+	}
+	secrets, err := secretLister.Secrets(common.GardenNamespace).List(selector)
+	if err != nil {
+		return false, err
+	}
+
+	for _, secret := range secrets {
+		_, defaultDomain, _, _, err := common.GetDomainInfoFromAnnotations(secret.Annotations)
+		if err != nil {
+			return false, err
+		}
+		if domain == defaultDomain || strings.HasSuffix(domain, "."+defaultDomain) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // assignDefaultDomainIfNeeded generates a domain <shoot-name>.<project-name>.<default-domain>
 // and sets it in the shoot resource in the `spec.dns.domain` field.
 // If for any reason no domain can be generated, no domain is assigned to the Shoot.
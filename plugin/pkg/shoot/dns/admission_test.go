@@ -159,6 +159,52 @@ var _ = Describe("dns", func() {
 
 				Expect(err).To(MatchError(apierrors.NewBadRequest("shoot domain field .spec.dns.domain must be set if provider != unmanaged")))
 			})
+
+			It("should reject because a non-default domain was configured for the shoot without providers", func() {
+				shootDomain := "my-shoot.other.com"
+				shoot.Spec.DNS.Domain = &shootDomain
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{}
+
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&defaultDomainSecret)
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(MatchError(apierrors.NewBadRequest("shoot domain field .spec.dns.providers must contain at least one provider if .spec.dns.domain is set to a non-default domain (domain: my-shoot.other.com)")))
+			})
+
+			It("should reject because a domain only sharing a suffix with the default domain was configured without providers", func() {
+				shootDomain := fmt.Sprintf("my-shoot.not%s", domain)
+				shoot.Spec.DNS.Domain = &shootDomain
+
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&defaultDomainSecret)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+			})
+
+			It("should pass because a non-default domain was configured for the shoot with providers", func() {
+				var (
+					shootDomain    = "my-shoot.other.com"
+					providerType   = "aws-route53"
+					providerSecret = "my-dns-secret"
+				)
+				shoot.Spec.DNS.Domain = &shootDomain
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &providerType, SecretName: &providerSecret}}
+				shootBefore := shoot.DeepCopy()
+
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&defaultDomainSecret)
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot).To(Equal(*shootBefore))
+			})
 		})
 	})
 })
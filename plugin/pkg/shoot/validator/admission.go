@@ -18,24 +18,33 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 
 	"github.com/Masterminds/semver"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 )
@@ -43,12 +52,31 @@ import (
 const (
 	// PluginName is the name of this admission plugin.
 	PluginName = "ShootValidator"
+	// TimezoneConflictWarningAnnotation is the key of the audit annotation warning about hibernation schedules which
+	// use a different timezone than the maintenance time window of the shoot.
+	TimezoneConflictWarningAnnotation = "shootvalidator.admission.gardener.cloud/timezone-conflict"
+	// MachineImageAutoUpdateConflictWarningAnnotation is the key of the audit annotation warning about worker pools
+	// which pin their machine image version although it may be automatically updated.
+	MachineImageAutoUpdateConflictWarningAnnotation = "shootvalidator.admission.gardener.cloud/machine-image-auto-update-conflict"
+
+	// maintenanceTimeLayout is the layout of the begin and end of maintenance time windows.
+	maintenanceTimeLayout = "150405-0700"
 )
 
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
-		return New()
+		configuration, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := New()
+		if err != nil {
+			return nil, err
+		}
+		v.SetConfiguration(configuration)
+		return v, nil
 	})
 }
 
@@ -59,6 +87,7 @@ type ValidateShoot struct {
 	seedLister         listers.SeedLister
 	shootLister        listers.ShootLister
 	projectLister      listers.ProjectLister
+	configuration      *Configuration
 	readyFunc          admission.ReadyFunc
 }
 
@@ -71,10 +100,16 @@ var (
 // New creates a new ValidateShoot admission plugin.
 func New() (*ValidateShoot, error) {
 	return &ValidateShoot{
-		Handler: admission.NewHandler(admission.Create, admission.Update),
+		Handler:       admission.NewHandler(admission.Create, admission.Update),
+		configuration: &Configuration{},
 	}, nil
 }
 
+// SetConfiguration sets the configuration of the admission plugin.
+func (v *ValidateShoot) SetConfiguration(configuration *Configuration) {
+	v.configuration = configuration
+}
+
 // AssignReadyFunc assigns the ready function to the admission handler.
 func (v *ValidateShoot) AssignReadyFunc(f admission.ReadyFunc) {
 	v.readyFunc = f
@@ -152,6 +187,10 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		if !ok {
 			return apierrors.NewInternalError(errors.New("could not convert old resource into Shoot object"))
 		}
+		// The purpose is stated in an annotation, hence it is validated before updates with an unchanged spec are ignored.
+		if err := validatePurpose(newShoot.Annotations, oldShoot.Annotations); err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		}
 		if reflect.DeepEqual(newShoot.Spec, oldShoot.Spec) {
 			return nil
 		}
@@ -162,6 +201,43 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
 	}
 
+	// We only want to validate fields in the Shoot against the CloudProfile/Seed constraints which have changed.
+	// On CREATE operations we just use an empty Shoot object, forcing the validator functions to always validate.
+	// On UPDATE operations we fetch the current Shoot object.
+	var oldShoot *garden.Shoot
+	if a.GetOperation() == admission.Create {
+		oldShoot = &garden.Shoot{
+			Spec: garden.ShootSpec{
+				Cloud: garden.Cloud{
+					AWS: &garden.AWSCloud{
+						MachineImage: &garden.ShootMachineImage{},
+					},
+					Azure: &garden.AzureCloud{
+						MachineImage: &garden.ShootMachineImage{},
+					},
+					GCP: &garden.GCPCloud{
+						MachineImage: &garden.ShootMachineImage{},
+					},
+					Packet: &garden.PacketCloud{
+						MachineImage: &garden.ShootMachineImage{},
+					},
+					OpenStack: &garden.OpenStackCloud{
+						MachineImage: &garden.ShootMachineImage{},
+					},
+					Alicloud: &garden.Alicloud{
+						MachineImage: &garden.ShootMachineImage{},
+					},
+				},
+			},
+		}
+	} else if a.GetOperation() == admission.Update {
+		old, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewInternalError(errors.New("could not convert old resource into Shoot object"))
+		}
+		oldShoot = old
+	}
+
 	cloudProfile, err := v.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
 	if err != nil {
 		return apierrors.NewBadRequest(fmt.Sprintf("could not find referenced cloud profile: %+v", err.Error()))
@@ -199,6 +275,17 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		if project.DeletionTimestamp != nil {
 			return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' in project '%s' already marked for deletion", shoot.Name, project.Name))
 		}
+		// The project has been looked up by its declared namespace, hence the Shoot lives in it. Except for the Garden
+		// namespace, it is expected to follow the project namespace convention. A deviating namespace indicates a
+		// misconfigured project.
+		if namespace := *project.Spec.Namespace; namespace != common.GardenNamespace && !strings.HasPrefix(namespace, common.ProjectPrefix) {
+			return apierrors.NewBadRequest(fmt.Sprintf("the namespace of the shoot must start with %q (project: %s; namespace: %s)", common.ProjectPrefix, project.Name, namespace))
+		}
+	}
+
+	// We don't allow shoots to reference cloud profiles or secret bindings outside of the scope of their project.
+	if err := validateProjectScope(project.Name, shoot, oldShoot, v.configuration.ProjectScopes); err != nil {
+		return admission.NewForbidden(a, err)
 	}
 
 	// Check whether seed is protected or not. In case it is protected then we only allow Shoot resources to reference it which are part of the Garden namespace.
@@ -211,45 +298,144 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		return admission.NewForbidden(a, fmt.Errorf("cannot create or update shoot '%s' on seed '%s' already marked for deletion", shoot.Name, seed.Name))
 	}
 
+	// We don't allow shoots to pin a seed of another provider type, as no controller could reconcile this combination.
+	if seed != nil {
+		seedProviderType, err := v.seedProviderType(seed)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if len(seedProviderType) > 0 && seedProviderType != shoot.Spec.Provider.Type {
+			return admission.NewForbidden(a, fmt.Errorf("seed '%s' has provider type '%s' which differs from the provider type '%s' of shoot '%s'", seed.Name, seedProviderType, shoot.Spec.Provider.Type, shoot.Name))
+		}
+	}
+
+	// We don't allow shoots to use a seed which lacks capabilities required by the features enabled for the shoot.
+	if seed != nil {
+		if missing := gardencorev1alpha1helper.MissingSeedCapabilities(shoot.ObjectMeta, seed.ObjectMeta); len(missing) > 0 {
+			return admission.NewForbidden(a, fmt.Errorf("seed '%s' does not support the capabilities %v required by shoot '%s'", seed.Name, missing, shoot.Name))
+		}
+	}
+
+	// We don't allow shoots to pin a seed which is excluded by the seed selector of the cloud profile, as the scheduler
+	// would never have chosen it.
+	if seed != nil && cloudProfile.Spec.SeedSelector != nil {
+		seedSelector, err := metav1.LabelSelectorAsSelector(cloudProfile.Spec.SeedSelector)
+		if err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("label selector conversion failed: %v for seedSelector: %v", *cloudProfile.Spec.SeedSelector, err))
+		}
+		if !seedSelector.Matches(labels.Set(seed.Labels)) {
+			return apierrors.NewBadRequest(fmt.Sprintf("seed '%s' does not match the seed selector of cloud profile '%s'", seed.Name, cloudProfile.Name))
+		}
+	}
+
 	if shoot.Spec.Provider.Type != cloudProfile.Spec.Type {
 		return apierrors.NewBadRequest(fmt.Sprintf("cloud provider in shoot (%s) is not equal to cloud provider in profile (%s)", shoot.Spec.Provider.Type, cloudProfile.Spec.Type))
 	}
 
-	// We only want to validate fields in the Shoot against the CloudProfile/Seed constraints which have changed.
-	// On CREATE operations we just use an empty Shoot object, forcing the validator functions to always validate.
-	// On UPDATE operations we fetch the current Shoot object.
-	var oldShoot *garden.Shoot
 	if a.GetOperation() == admission.Create {
-		oldShoot = &garden.Shoot{
-			Spec: garden.ShootSpec{
-				Cloud: garden.Cloud{
-					AWS: &garden.AWSCloud{
-						MachineImage: &garden.ShootMachineImage{},
-					},
-					Azure: &garden.AzureCloud{
-						MachineImage: &garden.ShootMachineImage{},
-					},
-					GCP: &garden.GCPCloud{
-						MachineImage: &garden.ShootMachineImage{},
-					},
-					Packet: &garden.PacketCloud{
-						MachineImage: &garden.ShootMachineImage{},
-					},
-					OpenStack: &garden.OpenStackCloud{
-						MachineImage: &garden.ShootMachineImage{},
-					},
-					Alicloud: &garden.Alicloud{
-						MachineImage: &garden.ShootMachineImage{},
-					},
-				},
-			},
+		if err := validatePurpose(shoot.Annotations, nil); err != nil {
+			return apierrors.NewBadRequest(err.Error())
 		}
-	} else if a.GetOperation() == admission.Update {
-		old, ok := a.GetOldObject().(*garden.Shoot)
-		if !ok {
-			return apierrors.NewInternalError(errors.New("could not convert old resource into Shoot object"))
+	}
+
+	if err := validateAddonResourceBudget(shoot.Spec.Addons, oldShoot.Spec.Addons, v.configuration.AddonResourceBudget); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateRegionHasZones(cloudProfile, shoot.Spec.Provider.Type, shoot.Spec.Region, oldShoot.Spec.Region); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateDNSDomainLength(shoot.Spec.DNS, oldShoot.Spec.DNS, field.NewPath("spec", "dns", "domain")); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateDNSProviderTypes(shoot.Spec.DNS, oldShoot.Spec.DNS, v.configuration.DNSProviderTypes, field.NewPath("spec", "dns", "providers")); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	// Shoots exceeding a lowered limit may still be updated as long as they don't add further worker pools.
+	if maxWorkerPools := v.configuration.MaxWorkerPools; maxWorkerPools > 0 && len(shoot.Spec.Provider.Workers) > maxWorkerPools && len(shoot.Spec.Provider.Workers) > len(oldShoot.Spec.Provider.Workers) {
+		return apierrors.NewBadRequest(fmt.Sprintf("the shoot must not define more than %d worker pools (found: %d)", maxWorkerPools, len(shoot.Spec.Provider.Workers)))
+	}
+
+	if err := validateKubeletConfigMode(shoot.Spec.Kubernetes.Kubelet, oldShoot.Spec.Kubernetes.Kubelet, field.NewPath("spec", "kubernetes", "kubelet")); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateClusterAutoscalerExpander(shoot.Spec.Kubernetes.ClusterAutoscaler, oldShoot.Spec.Kubernetes.ClusterAutoscaler, field.NewPath("spec", "kubernetes", "clusterAutoscaler", "expander")); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+	for i, worker := range shoot.Spec.Provider.Workers {
+		var (
+			idxPath   = field.NewPath("spec", "provider", "workers").Index(i)
+			oldWorker = oldWorkerByName(oldShoot, worker.Name)
+		)
+		if err := validateWorkerRollingUpdate(worker, oldWorker, idxPath); err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		}
+		var oldVolume *garden.Volume
+		if oldWorker != nil {
+			oldVolume = oldWorker.Volume
+		}
+		if err := validateWorkerVolume(worker.Volume, oldVolume, idxPath.Child("volume")); err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		}
+		var oldLabels map[string]string
+		if oldWorker != nil {
+			oldLabels = oldWorker.Labels
+		}
+		if err := validateWorkerLabels(worker.Labels, oldLabels, idxPath.Child("labels")); err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		}
+		if worker.Kubernetes == nil {
+			continue
+		}
+		var oldKubelet *garden.KubeletConfig
+		if oldWorker != nil && oldWorker.Kubernetes != nil {
+			oldKubelet = oldWorker.Kubernetes.Kubelet
+		}
+		if err := validateKubeletConfigMode(worker.Kubernetes.Kubelet, oldKubelet, idxPath.Child("kubernetes", "kubelet")); err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		}
+	}
+
+	if err := validateStorageFeatureGates(shoot); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+
+	if err := validateKubeletSwap(shoot); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+
+	if err := validateConsistentFeatureGates(shoot, oldShoot); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	if err := validateTimezoneConsistency(shoot, oldShoot, time.Now()); err != nil {
+		if v.configuration.RejectConflictingTimezones {
+			return apierrors.NewBadRequest(err.Error())
+		}
+		if err := a.AddAnnotation(TimezoneConflictWarningAnnotation, err.Error()); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+
+	// Only the machine images stated when creating a Shoot are pinned deliberately, later on they are always set (either
+	// defaulted or updated by the maintenance).
+	if a.GetOperation() == admission.Create {
+		defaultImage, err := getDefaultMachineImage(cloudProfile.Spec.MachineImages)
+		if err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		}
+		if err := validateMachineImageAutoUpdate(shoot, defaultImage); err != nil {
+			if v.configuration.RejectConflictingMachineImageAutoUpdate {
+				return apierrors.NewBadRequest(err.Error())
+			}
+			if err := a.AddAnnotation(MachineImageAutoUpdateConflictWarningAnnotation, err.Error()); err != nil {
+				return apierrors.NewInternalError(err)
+			}
 		}
-		oldShoot = old
 	}
 
 	var (
@@ -494,7 +680,16 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		}
 	}
 
+	if err := validateNodeCIDRMaskSize(shoot, oldShoot); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
+	if err := validatePodNetworkCapacity(shoot, oldShoot); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+
 	allErrs = append(allErrs, validateProvider(validationContext)...)
+	allErrs = append(allErrs, validateMinimumMachineImageVersions(v.configuration.MinimumMachineImageVersions, shoot, oldShoot)...)
 
 	dnsErrors, err := validateDNSDomainUniqueness(v.shootLister, shoot.Name, shoot.Spec.DNS)
 	if err != nil {
@@ -868,8 +1063,15 @@ func validateProvider(c *validationContext) field.ErrorList {
 		if ok, validMachineTypes := validateMachineTypes(c.cloudProfile.Spec.MachineTypes, worker.Machine.Type, oldWorker.Machine.Type, c.cloudProfile.Spec.Regions, c.shoot.Spec.Region, worker.Zones); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "type"), worker.Machine.Type, validMachineTypes))
 		}
+		if worker.Machine.Type != oldWorker.Machine.Type && isMachineTypeDeprecated(c.cloudProfile.Spec.MachineTypes, worker.Machine.Type) && c.shoot.Annotations[v1alpha1constants.AnnotationShootAcknowledgeDeprecatedMachineTypes] != "true" {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("machine", "type"), fmt.Sprintf("machine type %s is deprecated, its usage must be acknowledged with the %s=true annotation", worker.Machine.Type, v1alpha1constants.AnnotationShootAcknowledgeDeprecatedMachineTypes)))
+		}
 		if ok, validMachineImages := validateMachineImagesConstraints(c.cloudProfile.Spec.MachineImages, worker.Machine.Image, oldWorker.Machine.Image); !ok {
-			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "image"), worker.Machine.Image, validMachineImages))
+			if worker.Machine.Image != nil && len(validMachineImages) == 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("machine", "image", "name"), fmt.Sprintf("all versions of machine image %s offered by the cloud profile are expired", worker.Machine.Image.Name)))
+			} else {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "image"), worker.Machine.Image, validMachineImages))
+			}
 		}
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.VolumeTypes, worker.Volume, oldWorker.Volume, c.cloudProfile.Spec.Regions, c.shoot.Spec.Region, worker.Zones); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volume", "type"), worker.Volume, validVolumeTypes))
@@ -890,101 +1092,869 @@ func validateProvider(c *validationContext) field.ErrorList {
 	return allErrs
 }
 
-func validateDNSDomainUniqueness(shootLister listers.ShootLister, name string, dns *garden.DNS) (field.ErrorList, error) {
-	var (
-		allErrs = field.ErrorList{}
-		dnsPath = field.NewPath("spec", "dns", "domain")
-	)
+// validateMinimumMachineImageVersions checks that the machine images of all worker pools are not older than the
+// configured minimum version for the Kubernetes minor version of the shoot. Worker pools whose machine image is
+// unchanged are only checked if the Kubernetes minor version of the shoot changes.
+func validateMinimumMachineImageVersions(minimums []MinimumMachineImageVersion, shoot, oldShoot *garden.Shoot) field.ErrorList {
+	allErrs := field.ErrorList{}
 
-	if dns == nil || dns.Domain == nil {
-		return allErrs, nil
+	if len(minimums) == 0 {
+		return allErrs
 	}
 
-	shoots, err := shootLister.Shoots(metav1.NamespaceAll).List(labels.Everything())
+	kubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
-		return allErrs, err
+		return allErrs
+	}
+	minorVersionChanged := true
+	if oldKubernetesVersion, err := semver.NewVersion(oldShoot.Spec.Kubernetes.Version); err == nil {
+		minorVersionChanged = oldKubernetesVersion.Major() != kubernetesVersion.Major() || oldKubernetesVersion.Minor() != kubernetesVersion.Minor()
 	}
 
-	for _, shoot := range shoots {
-		if shoot.Name == name {
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.Machine.Image == nil {
 			continue
 		}
-
-		var domain *string
-		if shoot.Spec.DNS != nil {
-			domain = shoot.Spec.DNS.Domain
-		}
-		if domain == nil {
+		if oldWorker := oldWorkerByName(oldShoot, worker.Name); !minorVersionChanged && oldWorker != nil && apiequality.Semantic.DeepEqual(worker.Machine.Image, oldWorker.Machine.Image) {
 			continue
 		}
 
-		// Prevent that this shoot uses the exact same domain of any other shoot in the system.
-		if *domain == *dns.Domain {
-			allErrs = append(allErrs, field.Duplicate(dnsPath, *dns.Domain))
-			break
+		imageVersion, err := semver.NewVersion(worker.Machine.Image.Version)
+		if err != nil {
+			continue
 		}
 
-		// Prevent that this shoot uses a subdomain of the domain of any other shoot in the system.
-		if hasDomainIntersection(*domain, *dns.Domain) {
-			allErrs = append(allErrs, field.Forbidden(dnsPath, "the domain is already used by another shoot or it is a subdomain of an already used domain"))
-			break
+		for _, minimum := range minimums {
+			if minimum.Name != worker.Machine.Image.Name {
+				continue
+			}
+
+			minimumKubernetesVersion, err := semver.NewVersion(minimum.KubernetesVersion)
+			if err != nil || minimumKubernetesVersion.Major() != kubernetesVersion.Major() || minimumKubernetesVersion.Minor() != kubernetesVersion.Minor() {
+				continue
+			}
+
+			minimumVersion, err := semver.NewVersion(minimum.Version)
+			if err != nil {
+				continue
+			}
+
+			if imageVersion.LessThan(minimumVersion) {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "provider", "workers").Index(i).Child("machine", "image", "version"), fmt.Sprintf("machine image %s must at least have version %s for Kubernetes %d.%d", minimum.Name, minimum.Version, kubernetesVersion.Major(), kubernetesVersion.Minor())))
+			}
 		}
 	}
 
-	return allErrs, nil
+	return allErrs
 }
 
-// hasDomainIntersection checks if domainA is a suffix of domainB or domainB is a suffix of domainA.
-func hasDomainIntersection(domainA, domainB string) bool {
-	if domainA == domainB {
-		return true
+// oldWorkerByName returns the worker pool with the given name of the old shoot or nil if it has no such worker pool.
+func oldWorkerByName(oldShoot *garden.Shoot, name string) *garden.Worker {
+	for i, worker := range oldShoot.Spec.Provider.Workers {
+		if worker.Name == name {
+			return &oldShoot.Spec.Provider.Workers[i]
+		}
 	}
+	return nil
+}
 
-	var short, long string
-	if len(domainA) > len(domainB) {
-		short = domainB
-		long = domainA
-	} else {
-		short = domainA
-		long = domainB
+// featureGateDynamicKubeletConfig is the name of the Kubernetes feature gate that allows to reconfigure the kubelet
+// of live nodes via ConfigMaps.
+const featureGateDynamicKubeletConfig = "DynamicKubeletConfig"
+
+// validateKubeletConfigMode checks that the given kubelet configuration does not pin static kubelet settings while
+// enabling dynamic kubelet configuration at the same time as it would be ambiguous which settings take precedence.
+// Unchanged kubelet configurations are not checked.
+func validateKubeletConfigMode(kubelet, oldKubelet *garden.KubeletConfig, fldPath *field.Path) error {
+	if kubelet == nil || apiequality.Semantic.DeepEqual(kubelet, oldKubelet) {
+		return nil
 	}
 
-	if !strings.HasPrefix(short, ".") {
-		short = fmt.Sprintf(".%s", short)
+	if enabled, ok := kubelet.FeatureGates[featureGateDynamicKubeletConfig]; !ok || !enabled {
+		return nil
 	}
 
-	return strings.HasSuffix(long, short)
+	staticConfig := kubelet.DeepCopy()
+	staticConfig.FeatureGates = nil
+	if apiequality.Semantic.DeepEqual(*staticConfig, garden.KubeletConfig{}) {
+		return nil
+	}
+
+	return fmt.Errorf("%s: static kubelet configuration must not be set when the %s feature gate is enabled", fldPath.String(), featureGateDynamicKubeletConfig)
 }
 
-func validateKubernetesVersionConstraints(constraints []garden.ExpirableVersion, shootVersion, oldShootVersion string) (bool, []string, *semver.Version) {
-	if shootVersion == oldShootVersion {
-		return true, nil, nil
+// clusterAutoscalerExpanders contains the expanders of the cluster autoscaler which may be configured for shoots.
+var clusterAutoscalerExpanders = sets.NewString(
+	string(garden.ClusterAutoscalerExpanderLeastWaste),
+	string(garden.ClusterAutoscalerExpanderMostPods),
+	string(garden.ClusterAutoscalerExpanderPriority),
+	string(garden.ClusterAutoscalerExpanderRandom),
+)
+
+// validateClusterAutoscalerExpander checks that the expander configured for the cluster autoscaler of the shoot is
+// known, as the cluster autoscaler would refuse to start otherwise. Unchanged expanders are not checked.
+func validateClusterAutoscalerExpander(clusterAutoscaler, oldClusterAutoscaler *garden.ClusterAutoscaler, fldPath *field.Path) error {
+	if clusterAutoscaler == nil || clusterAutoscaler.Expander == nil {
+		return nil
+	}
+	if oldClusterAutoscaler != nil && apiequality.Semantic.DeepEqual(clusterAutoscaler.Expander, oldClusterAutoscaler.Expander) {
+		return nil
 	}
+	if expander := string(*clusterAutoscaler.Expander); !clusterAutoscalerExpanders.Has(expander) {
+		return fmt.Errorf("%s: unknown expander %q, must be one of %v", fldPath.String(), expander, clusterAutoscalerExpanders.List())
+	}
+	return nil
+}
 
-	shootVersionSplit := strings.Split(shootVersion, ".")
+// defaultNodeCIDRMaskSize is the mask size of the pod network ranges the kube-controller-manager allocates per node if
+// not configured otherwise.
+const defaultNodeCIDRMaskSize = 24
+
+// validateNodeCIDRMaskSize checks that the pod network of the shoot can be divided into per-node ranges of the
+// configured node CIDR mask size and that it provides a range for the maximum number of nodes of all worker pools.
+// Shoots with an unchanged pod network and node CIDR mask size are only checked if the maximum number of nodes grows.
+func validateNodeCIDRMaskSize(shoot, oldShoot *garden.Shoot) error {
+	if shoot.Spec.Networking.Pods == nil {
+		return nil
+	}
 	var (
-		shootVersionMajor, shootVersionMinor int64
-		getLatestPatchVersion                bool
+		nodeCIDRMaskSize = shootNodeCIDRMaskSize(shoot)
+		maximumNodes     = shootMaximumNodes(shoot)
 	)
-	if len(shootVersionSplit) == 2 {
-		// add a fake patch version to avoid manual parsing
-		fakeShootVersion := shootVersion + ".0"
-		version, err := semver.NewVersion(fakeShootVersion)
-		if err == nil {
-			getLatestPatchVersion = true
-			shootVersionMajor = version.Major()
-			shootVersionMinor = version.Minor()
-		}
+	if apiequality.Semantic.DeepEqual(shoot.Spec.Networking.Pods, oldShoot.Spec.Networking.Pods) && nodeCIDRMaskSize == shootNodeCIDRMaskSize(oldShoot) && maximumNodes <= shootMaximumNodes(oldShoot) {
+		return nil
 	}
 
-	validValues := []string{}
-	var latestVersion *semver.Version
-	for _, versionConstraint := range constraints {
-		if versionConstraint.ExpirationDate != nil && versionConstraint.ExpirationDate.Time.Before(time.Now()) {
-			continue
-		}
-
-		validValues = append(validValues, versionConstraint.Version)
+	_, podNetwork, err := net.ParseCIDR(*shoot.Spec.Networking.Pods)
+	if err != nil {
+		return nil
+	}
+	podNetworkMaskSize, bits := podNetwork.Mask.Size()
+	if bits != net.IPv4len*8 {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "kubernetes", "kubeControllerManager", "nodeCIDRMaskSize")
+	if nodeCIDRMaskSize < podNetworkMaskSize || nodeCIDRMaskSize > bits {
+		return fmt.Errorf("%s: the per-node range /%d cannot be allocated from the pod network %s", fldPath.String(), nodeCIDRMaskSize, podNetwork.String())
+	}
+	if availableRanges := int64(1) << uint(nodeCIDRMaskSize-podNetworkMaskSize); availableRanges < maximumNodes {
+		return fmt.Errorf("%s: the pod network %s only provides %d per-node range(s) of size /%d but the worker pools may have up to %d nodes", fldPath.String(), podNetwork.String(), availableRanges, nodeCIDRMaskSize, maximumNodes)
+	}
+	return nil
+}
+
+// shootNodeCIDRMaskSize returns the node CIDR mask size configured for the shoot or the default one.
+func shootNodeCIDRMaskSize(shoot *garden.Shoot) int {
+	if kcm := shoot.Spec.Kubernetes.KubeControllerManager; kcm != nil && kcm.NodeCIDRMaskSize != nil {
+		return *kcm.NodeCIDRMaskSize
+	}
+	return defaultNodeCIDRMaskSize
+}
+
+// shootMaximumNodes returns the maximum number of nodes of all worker pools of the shoot.
+func shootMaximumNodes(shoot *garden.Shoot) int64 {
+	var maximumNodes int64
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maximumNodes += int64(worker.Maximum)
+	}
+	return maximumNodes
+}
+
+// defaultMaxPods is the maximum number of pods per node the kubelet allows if not configured otherwise.
+const defaultMaxPods = 110
+
+// validatePodNetworkCapacity checks that the pod network of the shoot provides enough addresses for the maximum number
+// of pods on the maximum number of nodes of all worker pools. The maximum number of pods configured for the kubelets of
+// a worker pool takes precedence over the one configured for all kubelets of the shoot. Shoots with an unchanged pod
+// network are only checked if the maximum number of pods grows.
+func validatePodNetworkCapacity(shoot, oldShoot *garden.Shoot) error {
+	if shoot.Spec.Networking.Pods == nil {
+		return nil
+	}
+	requiredPodIPs := shootMaximumPods(shoot)
+	if apiequality.Semantic.DeepEqual(shoot.Spec.Networking.Pods, oldShoot.Spec.Networking.Pods) && requiredPodIPs <= shootMaximumPods(oldShoot) {
+		return nil
+	}
+
+	_, podNetwork, err := net.ParseCIDR(*shoot.Spec.Networking.Pods)
+	if err != nil {
+		return nil
+	}
+	podNetworkMaskSize, bits := podNetwork.Mask.Size()
+	if bits != net.IPv4len*8 {
+		return nil
+	}
+
+	if availablePodIPs := int64(1) << uint(bits-podNetworkMaskSize); availablePodIPs < requiredPodIPs {
+		return fmt.Errorf("%s: the pod network %s only provides %d address(es) but the worker pools may run up to %d pods", field.NewPath("spec", "networking", "pods").String(), podNetwork.String(), availablePodIPs, requiredPodIPs)
+	}
+	return nil
+}
+
+// shootMaximumPods returns the maximum number of pods on the maximum number of nodes of all worker pools of the shoot.
+func shootMaximumPods(shoot *garden.Shoot) int64 {
+	var (
+		shootMaxPods = int64(defaultMaxPods)
+		maximumPods  int64
+	)
+
+	if kubelet := shoot.Spec.Kubernetes.Kubelet; kubelet != nil && kubelet.MaxPods != nil {
+		shootMaxPods = int64(*kubelet.MaxPods)
+	}
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxPods := shootMaxPods
+		if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil && worker.Kubernetes.Kubelet.MaxPods != nil {
+			maxPods = int64(*worker.Kubernetes.Kubelet.MaxPods)
+		}
+		maximumPods += int64(worker.Maximum) * maxPods
+	}
+	return maximumPods
+}
+
+// seedProviderType returns the provider type of the given seed. If the seed does not state its provider type, the type
+// of the cloud profile it references is returned (or an empty string if it does not reference one either).
+func (v *ValidateShoot) seedProviderType(seed *garden.Seed) (string, error) {
+	if len(seed.Spec.Provider.Type) > 0 {
+		return seed.Spec.Provider.Type, nil
+	}
+	if len(seed.Spec.Cloud.Profile) == 0 {
+		return "", nil
+	}
+
+	cloudProfile, err := v.cloudProfileLister.Get(seed.Spec.Cloud.Profile)
+	if err != nil {
+		return "", fmt.Errorf("could not find cloud profile '%s' referenced by seed '%s': %v", seed.Spec.Cloud.Profile, seed.Name, err)
+	}
+	return cloudProfile.Spec.Type, nil
+}
+
+// validateProjectScope checks that the cloud profile and the secret binding referenced by the shoot are within the
+// scope of the given project (if a scope is configured for it). References which are unchanged compared to the old
+// shoot are not checked, so that narrowing the scope does not block updates of existing shoots.
+func validateProjectScope(projectName string, shoot, oldShoot *garden.Shoot, scopes []ProjectScope) error {
+	for _, scope := range scopes {
+		if scope.Project != projectName {
+			continue
+		}
+
+		if len(scope.CloudProfiles) > 0 && shoot.Spec.CloudProfileName != oldShoot.Spec.CloudProfileName && !utils.ValueExists(shoot.Spec.CloudProfileName, scope.CloudProfiles) {
+			return fmt.Errorf("cloud profile '%s' is not in the scope of project '%s' (allowed: %s)", shoot.Spec.CloudProfileName, projectName, strings.Join(scope.CloudProfiles, ", "))
+		}
+		if len(scope.SecretBindings) > 0 && shoot.Spec.SecretBindingName != oldShoot.Spec.SecretBindingName && !utils.ValueExists(shoot.Spec.SecretBindingName, scope.SecretBindings) {
+			return fmt.Errorf("secret binding '%s' is not in the scope of project '%s' (allowed: %s)", shoot.Spec.SecretBindingName, projectName, strings.Join(scope.SecretBindings, ", "))
+		}
+		return nil
+	}
+	return nil
+}
+
+// storageFeatureGates maps the storage related Kubernetes feature gates to the Kubernetes version introducing them.
+var storageFeatureGates = map[string]string{
+	"CSIBlockVolume":           "1.11",
+	"VolumeSnapshotDataSource": "1.12",
+	"CSIMigration":             "1.14",
+	"CSIMigrationAWS":          "1.14",
+	"CSIMigrationGCE":          "1.14",
+	"CSIMigrationOpenStack":    "1.14",
+	"ExpandCSIVolumes":         "1.14",
+	"CSIMigrationAzureDisk":    "1.15",
+	"CSIMigrationAzureFile":    "1.15",
+	"CSIInlineVolume":          "1.15",
+	"VolumePVCDataSource":      "1.15",
+}
+
+// validateStorageFeatureGates checks that the storage related feature gates enabled for the components of the shoot
+// are supported by its Kubernetes version, as the components would refuse to start otherwise.
+func validateStorageFeatureGates(shoot *garden.Shoot) error {
+	kubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil
+	}
+
+	type componentFeatureGates struct {
+		fldPath      *field.Path
+		featureGates map[string]bool
+	}
+
+	var (
+		kubernetesPath = field.NewPath("spec", "kubernetes")
+		components     []componentFeatureGates
+	)
+
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		components = append(components, componentFeatureGates{kubernetesPath.Child("kubeAPIServer", "featureGates"), kubeAPIServer.FeatureGates})
+	}
+	if kubeControllerManager := shoot.Spec.Kubernetes.KubeControllerManager; kubeControllerManager != nil {
+		components = append(components, componentFeatureGates{kubernetesPath.Child("kubeControllerManager", "featureGates"), kubeControllerManager.FeatureGates})
+	}
+	if kubeScheduler := shoot.Spec.Kubernetes.KubeScheduler; kubeScheduler != nil {
+		components = append(components, componentFeatureGates{kubernetesPath.Child("kubeScheduler", "featureGates"), kubeScheduler.FeatureGates})
+	}
+	if kubelet := shoot.Spec.Kubernetes.Kubelet; kubelet != nil {
+		components = append(components, componentFeatureGates{kubernetesPath.Child("kubelet", "featureGates"), kubelet.FeatureGates})
+	}
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil {
+			components = append(components, componentFeatureGates{field.NewPath("spec", "provider", "workers").Index(i).Child("kubernetes", "kubelet", "featureGates"), worker.Kubernetes.Kubelet.FeatureGates})
+		}
+	}
+
+	for _, component := range components {
+		for _, name := range sets.StringKeySet(component.featureGates).List() {
+			minimum, ok := storageFeatureGates[name]
+			if !ok || !component.featureGates[name] {
+				continue
+			}
+			if kubernetesVersion.LessThan(semver.MustParse(minimum)) {
+				return fmt.Errorf("%s: feature gate %s requires at least Kubernetes version %s (found: %s)", component.fldPath.Key(name).String(), name, minimum, shoot.Spec.Kubernetes.Version)
+			}
+		}
+	}
+	return nil
+}
+
+// consistentFeatureGates is the list of Kubernetes feature gates which must be set consistently for the kube-apiserver
+// and the kubelets, as the API server would accept objects the kubelets cannot handle (or vice versa) otherwise.
+var consistentFeatureGates = []string{
+	"BlockVolume",
+	"CSIBlockVolume",
+	"CSIInlineVolume",
+	"ExpandCSIVolumes",
+	"ExpandInUsePersistentVolumes",
+	"LocalStorageCapacityIsolation",
+}
+
+// validateConsistentFeatureGates checks that the feature gates which must be consistent across components are not
+// set to different values for the kube-apiserver and the kubelets (of the shoot and of its worker pools). Feature gates
+// which are only set for one of the components are not checked, as their defaults depend on the Kubernetes version.
+// If the feature gates of the kube-apiserver are unchanged compared to the old shoot, only kubelets whose feature gates
+// configuration changed are checked.
+func validateConsistentFeatureGates(shoot, oldShoot *garden.Shoot) error {
+	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+	if kubeAPIServer == nil || len(kubeAPIServer.FeatureGates) == 0 {
+		return nil
+	}
+
+	var oldAPIServerFeatureGates map[string]bool
+	if oldKubeAPIServer := oldShoot.Spec.Kubernetes.KubeAPIServer; oldKubeAPIServer != nil {
+		oldAPIServerFeatureGates = oldKubeAPIServer.FeatureGates
+	}
+	apiServerChanged := !apiequality.Semantic.DeepEqual(kubeAPIServer.FeatureGates, oldAPIServerFeatureGates)
+
+	type kubeletFeatureGates struct {
+		fldPath      *field.Path
+		featureGates map[string]bool
+	}
+
+	var kubelets []kubeletFeatureGates
+	if kubelet := shoot.Spec.Kubernetes.Kubelet; kubelet != nil {
+		if apiServerChanged || !apiequality.Semantic.DeepEqual(kubelet, oldShoot.Spec.Kubernetes.Kubelet) {
+			kubelets = append(kubelets, kubeletFeatureGates{field.NewPath("spec", "kubernetes", "kubelet", "featureGates"), kubelet.FeatureGates})
+		}
+	}
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes == nil || worker.Kubernetes.Kubelet == nil {
+			continue
+		}
+		if oldWorker := oldWorkerByName(oldShoot, worker.Name); !apiServerChanged && oldWorker != nil && apiequality.Semantic.DeepEqual(worker.Kubernetes, oldWorker.Kubernetes) {
+			continue
+		}
+		kubelets = append(kubelets, kubeletFeatureGates{field.NewPath("spec", "provider", "workers").Index(i).Child("kubernetes", "kubelet", "featureGates"), worker.Kubernetes.Kubelet.FeatureGates})
+	}
+
+	for _, name := range consistentFeatureGates {
+		apiServerEnabled, ok := kubeAPIServer.FeatureGates[name]
+		if !ok {
+			continue
+		}
+		for _, kubelet := range kubelets {
+			if kubeletEnabled, ok := kubelet.featureGates[name]; ok && kubeletEnabled != apiServerEnabled {
+				return fmt.Errorf("%s: feature gate %s must be set consistently for the kubelet and the kube-apiserver (kubelet: %t, kube-apiserver: %t)", kubelet.fldPath.Key(name).String(), name, kubeletEnabled, apiServerEnabled)
+			}
+		}
+	}
+	return nil
+}
+
+const (
+	// kubeletSwapFeatureGate is the kubelet feature gate which enables the support for nodes with swap memory.
+	kubeletSwapFeatureGate = "NodeSwap"
+	// kubeletSwapMinimumVersion is the first Kubernetes version whose kubelet supports swap memory.
+	kubeletSwapMinimumVersion = "1.22"
+)
+
+// validateKubeletSwap checks that swap is only enabled for the kubelets of the shoot if its Kubernetes version
+// supports it, as the nodes would not become ready otherwise.
+func validateKubeletSwap(shoot *garden.Shoot) error {
+	kubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil || !kubernetesVersion.LessThan(semver.MustParse(kubeletSwapMinimumVersion)) {
+		return nil
+	}
+
+	if kubelet := shoot.Spec.Kubernetes.Kubelet; kubelet != nil && kubelet.FeatureGates[kubeletSwapFeatureGate] {
+		return fmt.Errorf("%s: kubelet swap requires at least Kubernetes version %s (found: %s)", field.NewPath("spec", "kubernetes", "kubelet", "featureGates").Key(kubeletSwapFeatureGate).String(), kubeletSwapMinimumVersion, shoot.Spec.Kubernetes.Version)
+	}
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil && worker.Kubernetes.Kubelet.FeatureGates[kubeletSwapFeatureGate] {
+			return fmt.Errorf("%s: kubelet swap requires at least Kubernetes version %s (found: %s)", field.NewPath("spec", "provider", "workers").Index(i).Child("kubernetes", "kubelet", "featureGates").Key(kubeletSwapFeatureGate).String(), kubeletSwapMinimumVersion, shoot.Spec.Kubernetes.Version)
+		}
+	}
+	return nil
+}
+
+// validateTimezoneConsistency checks that the hibernation schedules of the shoot use the same UTC offset as its
+// maintenance time window, as operators would otherwise easily mistake when the actions take place. Locations observing
+// daylight saving time are consistent if either their standard or their daylight saving time offset in the year of the
+// given time matches. Schedules without a location are evaluated in UTC. Unparseable values are left to the API
+// validation. The check is skipped if neither the maintenance time window nor the hibernation schedules changed.
+func validateTimezoneConsistency(shoot, oldShoot *garden.Shoot, now time.Time) error {
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.TimeWindow == nil || shoot.Spec.Hibernation == nil {
+		return nil
+	}
+
+	var (
+		oldTimeWindow *garden.MaintenanceTimeWindow
+		oldSchedules  []garden.HibernationSchedule
+	)
+	if oldShoot.Spec.Maintenance != nil {
+		oldTimeWindow = oldShoot.Spec.Maintenance.TimeWindow
+	}
+	if oldShoot.Spec.Hibernation != nil {
+		oldSchedules = oldShoot.Spec.Hibernation.Schedules
+	}
+	if apiequality.Semantic.DeepEqual(shoot.Spec.Maintenance.TimeWindow, oldTimeWindow) && apiequality.Semantic.DeepEqual(shoot.Spec.Hibernation.Schedules, oldSchedules) {
+		return nil
+	}
+
+	begin, err := time.Parse(maintenanceTimeLayout, shoot.Spec.Maintenance.TimeWindow.Begin)
+	if err != nil {
+		return nil
+	}
+	_, maintenanceOffset := begin.Zone()
+
+	var (
+		january = time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		july    = time.Date(now.Year(), time.July, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	for i, schedule := range shoot.Spec.Hibernation.Schedules {
+		location := time.UTC
+		if schedule.Location != nil {
+			if location, err = time.LoadLocation(*schedule.Location); err != nil {
+				continue
+			}
+		}
+
+		_, januaryOffset := january.In(location).Zone()
+		_, julyOffset := july.In(location).Zone()
+		if januaryOffset != maintenanceOffset && julyOffset != maintenanceOffset {
+			return fmt.Errorf("%s: hibernation schedule is evaluated in location %s whose UTC offset differs from the one of the maintenance time window (%s)", field.NewPath("spec", "hibernation", "schedules").Index(i).Child("location"), location, begin.Format("-07:00"))
+		}
+	}
+	return nil
+}
+
+// addonResourceRequest contains the resources requested by an addon.
+type addonResourceRequest struct {
+	name   string
+	cpu    resource.Quantity
+	memory resource.Quantity
+}
+
+// enabledAddonResourceRequests returns the resources requested by the enabled addons of the shoot as defined in the
+// shoot-addons chart. Per-node addons (kube2iam) are counted once. Deprecated addons which are not deployed anymore are
+// not considered.
+func enabledAddonResourceRequests(addons *garden.Addons) []addonResourceRequest {
+	if addons == nil {
+		return nil
+	}
+
+	var requests []addonResourceRequest
+	if addons.KubernetesDashboard != nil && addons.KubernetesDashboard.Enabled {
+		requests = append(requests, addonResourceRequest{"kubernetes-dashboard", resource.MustParse("50m"), resource.MustParse("50Mi")})
+	}
+	if addons.NginxIngress != nil && addons.NginxIngress.Enabled {
+		requests = append(requests, addonResourceRequest{"nginx-ingress", resource.MustParse("100m"), resource.MustParse("100Mi")})
+	}
+	if addons.KubeLego != nil && addons.KubeLego.Enabled {
+		requests = append(requests, addonResourceRequest{"kube-lego", resource.MustParse("20m"), resource.MustParse("8Mi")})
+	}
+	if addons.Kube2IAM != nil && addons.Kube2IAM.Enabled {
+		requests = append(requests, addonResourceRequest{"kube2iam", resource.MustParse("10m"), resource.MustParse("16Mi")})
+	}
+	return requests
+}
+
+// validateAddonResourceBudget checks that the resources requested by the enabled addons of the shoot in total do not
+// exceed the given budget, as the addons would otherwise leave too little room for the workload of small clusters.
+// Unchanged addons are not checked.
+func validateAddonResourceBudget(addons, oldAddons *garden.Addons, budget *AddonResourceBudget) error {
+	if budget == nil || apiequality.Semantic.DeepEqual(addons, oldAddons) {
+		return nil
+	}
+
+	var (
+		requests    = enabledAddonResourceRequests(addons)
+		cpu, memory resource.Quantity
+	)
+
+	for _, request := range requests {
+		cpu.Add(request.cpu)
+		memory.Add(request.memory)
+	}
+	if cpu.Cmp(budget.CPU) <= 0 && memory.Cmp(budget.Memory) <= 0 {
+		return nil
+	}
+
+	sort.SliceStable(requests, func(i, j int) bool {
+		if c := requests[i].cpu.Cmp(requests[j].cpu); c != 0 {
+			return c > 0
+		}
+		return requests[i].memory.Cmp(requests[j].memory) > 0
+	})
+	addonDescriptions := make([]string, 0, len(requests))
+	for _, request := range requests {
+		addonDescriptions = append(addonDescriptions, fmt.Sprintf("%s (cpu: %s, memory: %s)", request.name, request.cpu.String(), request.memory.String()))
+	}
+
+	return fmt.Errorf("%s: the enabled addons request cpu: %s, memory: %s in total which exceeds the budget of cpu: %s, memory: %s; heaviest addons: %s", field.NewPath("spec", "addons").String(), cpu.String(), memory.String(), budget.CPU.String(), budget.Memory.String(), strings.Join(addonDescriptions, ", "))
+}
+
+// shootPurposes contains the values allowed for the purpose annotation of shoots.
+var shootPurposes = sets.NewString(v1alpha1constants.ShootPurposeEvaluation, v1alpha1constants.ShootPurposeDevelopment, v1alpha1constants.ShootPurposeProduction)
+
+// validatePurpose checks that the purpose annotation of the shoot, if present, carries one of the known purposes, as
+// unknown values would be treated inconsistently by the components deriving their defaults from it. A purpose which
+// is unchanged compared to the old annotations is not checked.
+func validatePurpose(annotations, oldAnnotations map[string]string) error {
+	purpose, ok := annotations[v1alpha1constants.GardenPurpose]
+	if !ok || shootPurposes.Has(purpose) {
+		return nil
+	}
+	if oldPurpose, ok := oldAnnotations[v1alpha1constants.GardenPurpose]; ok && oldPurpose == purpose {
+		return nil
+	}
+	return fmt.Errorf("%s: unsupported shoot purpose %q (supported purposes: %s)", field.NewPath("metadata", "annotations").Key(v1alpha1constants.GardenPurpose), purpose, strings.Join(shootPurposes.List(), ", "))
+}
+
+// validateMachineImageAutoUpdate checks that no worker pool of the shoot pins its machine image version if the machine
+// image version may be automatically updated (the default), as the maintenance would override the pinned version.
+// Worker pools using the default machine image of the cloud profile are not considered to pin their version, as it is
+// the same version their machine image would be defaulted to.
+func validateMachineImageAutoUpdate(shoot *garden.Shoot, defaultImage *garden.ShootMachineImage) error {
+	if maintenance := shoot.Spec.Maintenance; maintenance != nil && maintenance.AutoUpdate != nil && maintenance.AutoUpdate.MachineImageVersion != nil && !*maintenance.AutoUpdate.MachineImageVersion {
+		return nil
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if image := worker.Machine.Image; image != nil && len(image.Version) > 0 && !apiequality.Semantic.DeepEqual(image, defaultImage) {
+			return fmt.Errorf("%s: machine image version %s is pinned although the machine image version may be automatically updated (see %s)", field.NewPath("spec", "provider", "workers").Index(i).Child("machine", "image", "version"), worker.Machine.Image.Version, field.NewPath("spec", "maintenance", "autoUpdate", "machineImageVersion"))
+		}
+	}
+	return nil
+}
+
+// zonedProviderTypes contains the provider types whose regions are divided into availability zones.
+var zonedProviderTypes = sets.NewString("alicloud", "aws", "gcp", "openstack", "packet")
+
+// validateRegionHasZones checks that the region of the shoot declares at least one zone in the cloud profile if the
+// provider is zoned. Otherwise, the zone validation of the worker pools would pass vacuously. The region is not checked
+// if it is unchanged.
+func validateRegionHasZones(cloudProfile *garden.CloudProfile, providerType, region, oldRegion string) error {
+	if !zonedProviderTypes.Has(providerType) || region == oldRegion {
+		return nil
+	}
+
+	for _, r := range cloudProfile.Spec.Regions {
+		if r.Name == region && len(r.Zones) == 0 {
+			return fmt.Errorf("region %q of cloud profile %q does not declare any zones although provider type %q requires them", region, cloudProfile.Name, providerType)
+		}
+	}
+	return nil
+}
+
+// validateWorkerRollingUpdate checks that the rolling update parameters of the given worker pool are not negative and
+// that they are not both zero, as the machines of the pool could never be rolled in that case. The parameters are not
+// checked if they are unchanged compared to the given old worker pool (if any).
+func validateWorkerRollingUpdate(worker garden.Worker, oldWorker *garden.Worker, fldPath *field.Path) error {
+	if oldWorker != nil && apiequality.Semantic.DeepEqual(worker.MaxSurge, oldWorker.MaxSurge) && apiequality.Semantic.DeepEqual(worker.MaxUnavailable, oldWorker.MaxUnavailable) {
+		return nil
+	}
+
+	maxSurge, err := rollingUpdateValue(worker.MaxSurge, fldPath.Child("maxSurge"))
+	if err != nil {
+		return err
+	}
+	maxUnavailable, err := rollingUpdateValue(worker.MaxUnavailable, fldPath.Child("maxUnavailable"))
+	if err != nil {
+		return err
+	}
+
+	if maxSurge != nil && maxUnavailable != nil && *maxSurge == 0 && *maxUnavailable == 0 {
+		return fmt.Errorf("%s: maxSurge and maxUnavailable must not both be zero as the worker pool could never be rolled", fldPath.String())
+	}
+	return nil
+}
+
+// validateWorkerVolume checks that a worker pool requesting a volume type also requests a valid volume size, as the
+// infrastructure provider cannot create the volume otherwise. Unchanged volumes are not checked.
+func validateWorkerVolume(volume, oldVolume *garden.Volume, fldPath *field.Path) error {
+	if volume == nil || len(volume.Type) == 0 || apiequality.Semantic.DeepEqual(volume, oldVolume) {
+		return nil
+	}
+	if len(volume.Size) == 0 {
+		return fmt.Errorf("%s: volume size must be set if volume type %q is requested", fldPath.Child("size").String(), volume.Type)
+	}
+	if _, err := resource.ParseQuantity(volume.Size); err != nil {
+		return fmt.Errorf("%s: invalid volume size %q: %v", fldPath.Child("size").String(), volume.Size, err)
+	}
+	return nil
+}
+
+// reservedLabelDomains are the label namespaces reserved for Kubernetes. The kubelet refuses to set labels in these
+// namespaces (or their subdomains) on its node unless they are explicitly allowed.
+var reservedLabelDomains = []string{"kubernetes.io", "k8s.io"}
+
+// allowedReservedLabelDomains are the namespaces of reserved labels (including their subdomains) the kubelet may set.
+var allowedReservedLabelDomains = []string{"kubelet.kubernetes.io", "node.kubernetes.io"}
+
+// allowedReservedLabels are the reserved labels the kubelet may set.
+var allowedReservedLabels = sets.NewString(
+	"kubernetes.io/hostname",
+	"kubernetes.io/instance-type",
+	"kubernetes.io/os",
+	"kubernetes.io/arch",
+	"beta.kubernetes.io/instance-type",
+	"beta.kubernetes.io/os",
+	"beta.kubernetes.io/arch",
+	"failure-domain.beta.kubernetes.io/zone",
+	"failure-domain.beta.kubernetes.io/region",
+	"failure-domain.kubernetes.io/zone",
+	"failure-domain.kubernetes.io/region",
+	"topology.kubernetes.io/zone",
+	"topology.kubernetes.io/region",
+)
+
+// validateWorkerLabels checks that the given node labels of a worker pool do not use label namespaces reserved for
+// Kubernetes, as the kubelet would refuse to register its node with such labels. Labels which are already present in
+// the old node labels of the worker pool are not checked.
+func validateWorkerLabels(nodeLabels, oldNodeLabels map[string]string, fldPath *field.Path) error {
+	for key := range nodeLabels {
+		if _, ok := oldNodeLabels[key]; ok {
+			continue
+		}
+		if !isReservedLabel(key) || allowedReservedLabels.Has(key) {
+			continue
+		}
+
+		domain := strings.SplitN(key, "/", 2)[0]
+		if labelDomainMatches(domain, allowedReservedLabelDomains) {
+			continue
+		}
+		return fmt.Errorf("%s: label %q uses the reserved namespace %q which must not be set on nodes", fldPath.Key(key).String(), key, domain)
+	}
+	return nil
+}
+
+// isReservedLabel returns true if the namespace of the given label key is reserved for Kubernetes.
+func isReservedLabel(key string) bool {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	return labelDomainMatches(parts[0], reservedLabelDomains)
+}
+
+// labelDomainMatches returns true if the given domain equals one of the given domains or is a subdomain of it.
+func labelDomainMatches(domain string, domains []string) bool {
+	for _, d := range domains {
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// rollingUpdateValue returns the integer or percentage value of the given rolling update parameter or nil if it is
+// not set. Negative values are rejected.
+func rollingUpdateValue(value *intstr.IntOrString, fldPath *field.Path) (*int, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	v := value.IntValue()
+	if value.Type == intstr.String {
+		percentage, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+		if err != nil || !strings.HasSuffix(value.StrVal, "%") {
+			return nil, fmt.Errorf("%s: invalid value %q, must be an integer or a percentage", fldPath.String(), value.StrVal)
+		}
+		v = percentage
+	}
+
+	if v < 0 {
+		return nil, fmt.Errorf("%s: must not be negative (%s)", fldPath.String(), value.String())
+	}
+	return &v, nil
+}
+
+func validateDNSDomainUniqueness(shootLister listers.ShootLister, name string, dns *garden.DNS) (field.ErrorList, error) {
+	var (
+		allErrs = field.ErrorList{}
+		dnsPath = field.NewPath("spec", "dns", "domain")
+	)
+
+	if dns == nil || dns.Domain == nil {
+		return allErrs, nil
+	}
+
+	shoots, err := shootLister.Shoots(metav1.NamespaceAll).List(labels.Everything())
+	if err != nil {
+		return allErrs, err
+	}
+
+	for _, shoot := range shoots {
+		if shoot.Name == name {
+			continue
+		}
+
+		var domain *string
+		if shoot.Spec.DNS != nil {
+			domain = shoot.Spec.DNS.Domain
+		}
+		if domain == nil {
+			continue
+		}
+
+		// Prevent that this shoot uses the exact same domain of any other shoot in the system.
+		if *domain == *dns.Domain {
+			allErrs = append(allErrs, field.Duplicate(dnsPath, *dns.Domain))
+			break
+		}
+
+		// Prevent that this shoot uses a subdomain of the domain of any other shoot in the system.
+		if hasDomainIntersection(*domain, *dns.Domain) {
+			allErrs = append(allErrs, field.Forbidden(dnsPath, "the domain is already used by another shoot or it is a subdomain of an already used domain"))
+			break
+		}
+	}
+
+	return allErrs, nil
+}
+
+const (
+	// maxDomainLength is the maximum length of a fully qualified domain name.
+	maxDomainLength = 253
+	// maxDomainLabelLength is the maximum length of a single label of a domain name.
+	maxDomainLabelLength = 63
+)
+
+// validateDNSDomainLength checks that the domain of the shoot does not exceed the maximum length of a domain name
+// and that none of its labels exceeds the maximum label length, as otherwise no certificates can be issued for it.
+// Domains of shoots using unmanaged DNS as well as unchanged DNS configurations are not checked.
+func validateDNSDomainLength(dns, oldDNS *garden.DNS, fldPath *field.Path) error {
+	if dns == nil || dns.Domain == nil || apiequality.Semantic.DeepEqual(dns, oldDNS) {
+		return nil
+	}
+
+	for _, provider := range dns.Providers {
+		if provider.Type != nil && *provider.Type == garden.DNSUnmanaged {
+			return nil
+		}
+	}
+
+	domain := *dns.Domain
+	if len(domain) > maxDomainLength {
+		return fmt.Errorf("%s must not exceed %d characters (length: %d)", fldPath, maxDomainLength, len(domain))
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) > maxDomainLabelLength {
+			return fmt.Errorf("%s must not contain labels exceeding %d characters (label: %s)", fldPath, maxDomainLabelLength, label)
+		}
+	}
+	return nil
+}
+
+// validateDNSProviderTypes checks that the DNS providers of the shoot only use registered provider types, as no records
+// would ever be provisioned for unknown types. The provider types are not checked if no registered types are given.
+// Provider types which are already used by the old DNS configuration are not checked either.
+func validateDNSProviderTypes(dns, oldDNS *garden.DNS, registeredTypes []string, fldPath *field.Path) error {
+	if dns == nil || len(registeredTypes) == 0 {
+		return nil
+	}
+
+	oldTypes := sets.NewString()
+	if oldDNS != nil {
+		for _, provider := range oldDNS.Providers {
+			if provider.Type != nil {
+				oldTypes.Insert(*provider.Type)
+			}
+		}
+	}
+
+	registered := sets.NewString(registeredTypes...)
+	for i, provider := range dns.Providers {
+		if provider.Type == nil || *provider.Type == garden.DNSUnmanaged || oldTypes.Has(*provider.Type) {
+			continue
+		}
+		if !registered.Has(*provider.Type) {
+			return fmt.Errorf("%s: DNS provider type %q is not registered (registered types: %s)", fldPath.Index(i).Child("type"), *provider.Type, strings.Join(registered.List(), ", "))
+		}
+	}
+	return nil
+}
+
+// hasDomainIntersection checks if domainA is a suffix of domainB or domainB is a suffix of domainA.
+func hasDomainIntersection(domainA, domainB string) bool {
+	if domainA == domainB {
+		return true
+	}
+
+	var short, long string
+	if len(domainA) > len(domainB) {
+		short = domainB
+		long = domainA
+	} else {
+		short = domainA
+		long = domainB
+	}
+
+	if !strings.HasPrefix(short, ".") {
+		short = fmt.Sprintf(".%s", short)
+	}
+
+	return strings.HasSuffix(long, short)
+}
+
+func validateKubernetesVersionConstraints(constraints []garden.ExpirableVersion, shootVersion, oldShootVersion string) (bool, []string, *semver.Version) {
+	if shootVersion == oldShootVersion {
+		return true, nil, nil
+	}
+
+	shootVersionSplit := strings.Split(shootVersion, ".")
+	var (
+		shootVersionMajor, shootVersionMinor int64
+		getLatestPatchVersion                bool
+	)
+	if len(shootVersionSplit) == 2 {
+		// add a fake patch version to avoid manual parsing
+		fakeShootVersion := shootVersion + ".0"
+		version, err := semver.NewVersion(fakeShootVersion)
+		if err == nil {
+			getLatestPatchVersion = true
+			shootVersionMajor = version.Major()
+			shootVersionMinor = version.Minor()
+		}
+	}
+
+	validValues := []string{}
+	var latestVersion *semver.Version
+	for _, versionConstraint := range constraints {
+		if versionConstraint.ExpirationDate != nil && versionConstraint.ExpirationDate.Time.Before(time.Now()) {
+			continue
+		}
+
+		validValues = append(validValues, versionConstraint.Version)
 
 		if versionConstraint.Version == shootVersion {
 			return true, nil, nil
@@ -1011,6 +1981,17 @@ func validateKubernetesVersionConstraints(constraints []garden.ExpirableVersion,
 	return false, validValues, nil
 }
 
+// isMachineTypeDeprecated returns whether the given machine type is marked as deprecated in the cloud profile. Unlike
+// unusable machine types, deprecated ones may still be selected if the shoot acknowledges the deprecation.
+func isMachineTypeDeprecated(machineTypes []garden.MachineType, name string) bool {
+	for _, t := range machineTypes {
+		if t.Name == name {
+			return t.Deprecated != nil && *t.Deprecated
+		}
+	}
+	return false
+}
+
 func validateMachineTypes(constraints []garden.MachineType, machineType, oldMachineType string, regions []garden.Region, region string, zones []string) (bool, []string) {
 	if machineType == oldMachineType {
 		return true, nil
@@ -1181,37 +2162,43 @@ func getDefaultMachineImage(machineImages []garden.CloudProfileMachineImage) (*g
 	return &garden.ShootMachineImage{Name: firstMachineImageInCloudProfile.Name, Version: latestMachineImageVersion.Version}, nil
 }
 
+// validateMachineImagesConstraints returns the non-expired versions of the machine image offered by the cloud profile
+// if the given image is not among them. If the cloud profile does not offer an image with the given name, the non-expired
+// versions of all images are returned instead, i.e. an empty list means that all versions of the named image are expired.
 func validateMachineImagesConstraints(constraints []garden.CloudProfileMachineImage, image, oldImage *garden.ShootMachineImage) (bool, []string) {
 	if oldImage == nil || apiequality.Semantic.DeepEqual(image, oldImage) {
 		return true, nil
 	}
 
 	validValues := []string{}
-	if image == nil {
+	if image != nil {
+		found := false
 		for _, machineImage := range constraints {
-			for _, machineVersion := range machineImage.Versions {
-				if machineVersion.ExpirationDate != nil && machineVersion.ExpirationDate.Time.UTC().Before(time.Now().UTC()) {
-					continue
+			if machineImage.Name == image.Name {
+				found = true
+				for _, machineVersion := range machineImage.Versions {
+					if machineVersion.ExpirationDate != nil && machineVersion.ExpirationDate.Time.UTC().Before(time.Now().UTC()) {
+						continue
+					}
+					validValues = append(validValues, fmt.Sprintf("machineImage(%s:%s)", machineImage.Name, machineVersion.Version))
+
+					if machineVersion.Version == image.Version {
+						return true, nil
+					}
 				}
-				validValues = append(validValues, fmt.Sprintf("machineImage(%s:%s)", machineImage.Name, machineVersion.Version))
 			}
 		}
-
-		return false, validValues
+		if found {
+			return false, validValues
+		}
 	}
 
 	for _, machineImage := range constraints {
-		if machineImage.Name == image.Name {
-			for _, machineVersion := range machineImage.Versions {
-				if machineVersion.ExpirationDate != nil && machineVersion.ExpirationDate.Time.UTC().Before(time.Now().UTC()) {
-					continue
-				}
-				validValues = append(validValues, fmt.Sprintf("machineImage(%s:%s)", machineImage.Name, machineVersion.Version))
-
-				if machineVersion.Version == image.Version {
-					return true, nil
-				}
+		for _, machineVersion := range machineImage.Versions {
+			if machineVersion.ExpirationDate != nil && machineVersion.ExpirationDate.Time.UTC().Before(time.Now().UTC()) {
+				continue
 			}
+			validValues = append(validValues, fmt.Sprintf("machineImage(%s:%s)", machineImage.Name, machineVersion.Version))
 		}
 	}
 	return false, validValues
@@ -16,6 +16,7 @@ package validator_test
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
@@ -24,10 +25,13 @@ import (
 	"github.com/gardener/gardener/test"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apiserver/pkg/admission"
 )
 
@@ -397,6 +401,97 @@ var _ = Describe("validator", func() {
 				Expect(err.Error()).To(ContainSubstring("already marked for deletion"))
 			})
 
+			It("should reject Shoot resources whose project does not have a namespace", func() {
+				project.Spec.Namespace = nil
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("could not find referenced project"))
+			})
+
+			It("should reject Shoot resources in a project namespace not following the convention", func() {
+				otherNamespace := "other"
+				project.Spec.Namespace = &otherNamespace
+				shoot.Namespace = otherNamespace
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`the namespace of the shoot must start with "garden-"`))
+			})
+
+			It("should reject Shoot resources requiring a capability the seed does not advertise", func() {
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/required-seed-capabilities": "foo,bar"}
+				seed.Labels = map[string]string{"capability.seed.gardener.cloud/foo": "true"}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("does not support the capabilities [bar]"))
+			})
+
+			It("should not reject Shoot resources requiring capabilities the seed advertises", func() {
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/required-seed-capabilities": "foo"}
+				seed.Labels = map[string]string{"capability.seed.gardener.cloud/foo": "true"}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject Shoot resources pinning a seed excluded by the seed selector", func() {
+				cloudProfile.Spec.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}}
+				seed.Labels = map[string]string{"environment": "staging"}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("does not match the seed selector"))
+			})
+
+			It("should not reject Shoot resources pinning a seed matching the seed selector", func() {
+				cloudProfile.Spec.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}}
+				seed.Labels = map[string]string{"environment": "production"}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should reject Shoot resources with not fulfilling the length constraints", func() {
 				tooLongName := "too-long-namespace"
 				project.ObjectMeta = metav1.ObjectMeta{
@@ -686,6 +781,90 @@ var _ = Describe("validator", func() {
 				Expect(err).To(BeNil())
 			})
 
+			It("should reject because the specified domain exceeds the maximum domain length", func() {
+				managedDNSProvider := "aws-route53"
+				longDomain := strings.Repeat(strings.Repeat("a", 60)+".", 4) + "example.com"
+				shoot.Spec.DNS.Domain = &longDomain
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &managedDNSProvider}}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("must not exceed 253 characters"))
+			})
+
+			It("should reject because a label of the specified domain exceeds the maximum label length", func() {
+				managedDNSProvider := "aws-route53"
+				longLabelDomain := strings.Repeat("a", 64) + ".example.com"
+				shoot.Spec.DNS.Domain = &longLabelDomain
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &managedDNSProvider}}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("must not contain labels exceeding 63 characters"))
+			})
+
+			It("should not reject a domain within the length limits", func() {
+				managedDNSProvider := "aws-route53"
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &managedDNSProvider}}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates of shoots whose overlong domain is unchanged", func() {
+				managedDNSProvider := "aws-route53"
+				longLabelDomain := strings.Repeat("a", 64) + ".example.com"
+				shoot.Spec.DNS.Domain = &longLabelDomain
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &managedDNSProvider}}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not check the domain length for unmanaged DNS", func() {
+				longLabelDomain := strings.Repeat("a", 64) + ".example.com"
+				shoot.Spec.DNS.Domain = &longLabelDomain
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
 			It("should reject due to an invalid kubernetes version", func() {
 				shoot.Spec.Kubernetes.Version = "1.2.3"
 
@@ -825,6 +1004,70 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject due to a deprecated machine type without acknowledgment", func() {
+				cloudProfile.Spec.MachineTypes[0].Deprecated = makeBoolPointer(true)
+				shoot.Spec.Provider.Workers = []garden.Worker{
+					{
+						Machine: garden.Machine{
+							Type: "machine-type-1",
+						},
+					},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("machine type machine-type-1 is deprecated"))
+			})
+
+			It("should not reject due to a deprecated machine type with acknowledgment", func() {
+				cloudProfile.Spec.MachineTypes[0].Deprecated = makeBoolPointer(true)
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/acknowledge-deprecated-machine-types": "true"}
+				shoot.Spec.Provider.Workers = []garden.Worker{
+					{
+						Machine: garden.Machine{
+							Type: "machine-type-1",
+						},
+					},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should not reject due to a deprecated machine type which is already in use", func() {
+				cloudProfile.Spec.MachineTypes[0].Deprecated = makeBoolPointer(true)
+				shoot.Spec.Provider.Workers = []garden.Worker{
+					{
+						Machine: garden.Machine{
+							Type: "machine-type-1",
+						},
+					},
+				}
+				oldShoot := shoot.DeepCopy()
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should reject due to an invalid machine type", func() {
 				shoot.Spec.Cloud.AWS.Workers = []garden.Worker{
 					{
@@ -853,6 +1096,7 @@ var _ = Describe("validator", func() {
 						},
 						Volume: &garden.Volume{
 							Type: "not-allowed",
+							Size: "20Gi",
 						},
 					},
 				}
@@ -1128,6 +1372,7 @@ var _ = Describe("validator", func() {
 						},
 						Volume: &garden.Volume{
 							Type: "not-allowed",
+							Size: "20Gi",
 						},
 					},
 				}
@@ -1399,6 +1644,7 @@ var _ = Describe("validator", func() {
 						},
 						Volume: &garden.Volume{
 							Type: "not-allowed",
+							Size: "20Gi",
 						},
 					},
 				}
@@ -1642,6 +1888,7 @@ var _ = Describe("validator", func() {
 						},
 						Volume: &garden.Volume{
 							Type: "not-allowed",
+							Size: "20Gi",
 						},
 					},
 				}
@@ -2182,6 +2429,7 @@ var _ = Describe("validator", func() {
 						},
 						Volume: &garden.Volume{
 							Type: "not-allowed",
+							Size: "20Gi",
 						},
 					},
 				}
@@ -2527,6 +2775,88 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject due to a machine image name not offered by the cloud profile even if the version exists", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image = &garden.ShootMachineImage{
+					Name:    "unknown-image",
+					Version: validMachineImageVersions[0].Version,
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.provider.workers[0].machine.image: Unsupported value"))
+			})
+
+			It("should reject due to a machine image whose versions are all expired", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image = &garden.ShootMachineImage{
+					Name:    "expired-image",
+					Version: "1.0.0",
+				}
+
+				timeInThePast := metav1.Now().Add(time.Second * -1000)
+				cloudProfile.Spec.MachineImages = append(cloudProfile.Spec.MachineImages, garden.CloudProfileMachineImage{
+					Name: "expired-image",
+					Versions: []garden.ExpirableVersion{
+						{
+							Version:        "1.0.0",
+							ExpirationDate: &metav1.Time{Time: timeInThePast},
+						},
+						{
+							Version:        "1.1.0",
+							ExpirationDate: &metav1.Time{Time: timeInThePast},
+						},
+					},
+				})
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.provider.workers[0].machine.image.name: Forbidden: all versions of machine image expired-image offered by the cloud profile are expired"))
+				Expect(err.Error()).NotTo(ContainSubstring("Unsupported value"))
+			})
+
+			It("should not reject due to a machine image having at least one non-expired version", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image = &garden.ShootMachineImage{
+					Name:    "partially-expired-image",
+					Version: "1.1.0",
+				}
+
+				timeInThePast := metav1.Now().Add(time.Second * -1000)
+				cloudProfile.Spec.MachineImages = append(cloudProfile.Spec.MachineImages, garden.CloudProfileMachineImage{
+					Name: "partially-expired-image",
+					Versions: []garden.ExpirableVersion{
+						{
+							Version:        "1.0.0",
+							ExpirationDate: &metav1.Time{Time: timeInThePast},
+						},
+						{
+							Version: "1.1.0",
+						},
+					},
+				})
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should not reject due to an usable machine type", func() {
 				shoot.Spec.Provider.Workers = []garden.Worker{
 					{
@@ -2594,6 +2924,7 @@ var _ = Describe("validator", func() {
 						},
 						Volume: &garden.Volume{
 							Type: "not-allowed",
+							Size: "20Gi",
 						},
 					},
 				}
@@ -2623,5 +2954,1279 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 		})
-	})
-})
+
+		Context("kubelet configuration mode checks", func() {
+			var dynamicKubeletConfig = map[string]bool{"DynamicKubeletConfig": true}
+
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should reject because static kubelet configuration is set while dynamic kubelet configuration is enabled", func() {
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: dynamicKubeletConfig},
+					MaxPods:          makeInt32Pointer(50),
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("DynamicKubeletConfig"))
+			})
+
+			It("should reject because a worker sets static kubelet configuration while dynamic kubelet configuration is enabled", func() {
+				shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{
+					Kubelet: &garden.KubeletConfig{
+						KubernetesConfig: garden.KubernetesConfig{FeatureGates: dynamicKubeletConfig},
+						MaxPods:          makeInt32Pointer(50),
+					},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.provider.workers[0].kubernetes.kubelet"))
+			})
+
+			It("should allow dynamic kubelet configuration without static kubelet configuration", func() {
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: dynamicKubeletConfig},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow static kubelet configuration without dynamic kubelet configuration", func() {
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					MaxPods: makeInt32Pointer(50),
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates of shoots whose conflicting kubelet configuration is unchanged", func() {
+				shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{
+					Kubelet: &garden.KubeletConfig{
+						KubernetesConfig: garden.KubernetesConfig{FeatureGates: dynamicKubeletConfig},
+						MaxPods:          makeInt32Pointer(50),
+					},
+				}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates introducing a conflicting kubelet configuration", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: dynamicKubeletConfig},
+					MaxPods:          makeInt32Pointer(50),
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+		})
+
+		Context("minimum machine image version checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should reject because the machine image version is below the minimum for the Kubernetes version", func() {
+				admissionHandler.SetConfiguration(&Configuration{
+					MinimumMachineImageVersions: []MinimumMachineImageVersion{
+						{Name: validMachineImageName, KubernetesVersion: "1.6", Version: "0.0.2"},
+					},
+				})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("must at least have version 0.0.2"))
+			})
+
+			It("should allow a machine image version matching the minimum for the Kubernetes version", func() {
+				admissionHandler.SetConfiguration(&Configuration{
+					MinimumMachineImageVersions: []MinimumMachineImageVersion{
+						{Name: validMachineImageName, KubernetesVersion: "1.6", Version: validShootMachineImageVersion},
+					},
+				})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should ignore minimum versions for other Kubernetes minor versions", func() {
+				admissionHandler.SetConfiguration(&Configuration{
+					MinimumMachineImageVersions: []MinimumMachineImageVersion{
+						{Name: validMachineImageName, KubernetesVersion: "1.7", Version: "0.0.2"},
+					},
+				})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates of shoots whose worker pools keep a machine image below the minimum", func() {
+				admissionHandler.SetConfiguration(&Configuration{
+					MinimumMachineImageVersions: []MinimumMachineImageVersion{
+						{Name: validMachineImageName, KubernetesVersion: "1.6", Version: "0.0.2"},
+					},
+				})
+				shoot.Spec.Provider.Workers[0].Machine.Image = &garden.ShootMachineImage{Name: validMachineImageName, Version: validShootMachineImageVersion}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates adding a worker pool with a machine image below the minimum", func() {
+				admissionHandler.SetConfiguration(&Configuration{
+					MinimumMachineImageVersions: []MinimumMachineImageVersion{
+						{Name: validMachineImageName, KubernetesVersion: "1.6", Version: "0.0.2"},
+					},
+				})
+				oldShoot := shoot.DeepCopy()
+				newWorker := *shoot.Spec.Provider.Workers[0].DeepCopy()
+				newWorker.Name = "new-worker"
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, newWorker)
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.provider.workers[1].machine.image.version"))
+			})
+		})
+
+		Context("worker rolling update checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			DescribeTable("rolling update parameters",
+				func(maxSurge, maxUnavailable *intstr.IntOrString, matcher types.GomegaMatcher) {
+					shoot.Spec.Provider.Workers[0].MaxSurge = maxSurge
+					shoot.Spec.Provider.Workers[0].MaxUnavailable = maxUnavailable
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject both being zero", intOrStrPtr(intstr.FromInt(0)), intOrStrPtr(intstr.FromInt(0)), beBadRequest()),
+				Entry("should reject both being zero percent", intOrStrPtr(intstr.FromString("0%")), intOrStrPtr(intstr.FromInt(0)), beBadRequest()),
+				Entry("should reject a negative maxSurge", intOrStrPtr(intstr.FromInt(-1)), intOrStrPtr(intstr.FromInt(1)), beBadRequest()),
+				Entry("should reject a negative maxUnavailable percentage", intOrStrPtr(intstr.FromInt(1)), intOrStrPtr(intstr.FromString("-10%")), beBadRequest()),
+				Entry("should reject an invalid string value", intOrStrPtr(intstr.FromString("foo")), intOrStrPtr(intstr.FromInt(1)), beBadRequest()),
+				Entry("should allow a positive maxSurge with zero maxUnavailable", intOrStrPtr(intstr.FromInt(1)), intOrStrPtr(intstr.FromInt(0)), BeNil()),
+				Entry("should allow zero maxSurge with a positive maxUnavailable percentage", intOrStrPtr(intstr.FromInt(0)), intOrStrPtr(intstr.FromString("25%")), BeNil()),
+				Entry("should allow unset values", nil, nil, BeNil()),
+			)
+
+			It("should allow updates of worker pools keeping rolling update parameters which can never roll", func() {
+				shoot.Spec.Provider.Workers[0].MaxSurge = intOrStrPtr(intstr.FromInt(0))
+				shoot.Spec.Provider.Workers[0].MaxUnavailable = intOrStrPtr(intstr.FromInt(0))
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				Expect(admissionHandler.Admit(attrs, nil)).To(Succeed())
+			})
+
+			It("should reject updates changing the rolling update parameters to values which can never roll", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].MaxSurge = intOrStrPtr(intstr.FromInt(0))
+				shoot.Spec.Provider.Workers[0].MaxUnavailable = intOrStrPtr(intstr.FromInt(0))
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				Expect(admissionHandler.Admit(attrs, nil)).To(beBadRequest())
+			})
+		})
+
+		Context("purpose checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			DescribeTable("purpose annotation",
+				func(purpose *string, matcher types.GomegaMatcher) {
+					if purpose != nil {
+						metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "garden.sapcloud.io/purpose", *purpose)
+					}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject an unknown purpose", strPtr("testing"), beBadRequest()),
+				Entry("should reject an empty purpose", strPtr(""), beBadRequest()),
+				Entry("should allow the evaluation purpose", strPtr("evaluation"), BeNil()),
+				Entry("should allow the development purpose", strPtr("development"), BeNil()),
+				Entry("should allow the production purpose", strPtr("production"), BeNil()),
+				Entry("should allow no purpose", nil, BeNil()),
+			)
+
+			It("should reject updates changing only the purpose to an unknown one", func() {
+				oldShoot := shoot.DeepCopy()
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "garden.sapcloud.io/purpose", "testing")
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+
+			It("should allow updates of shoots whose unknown purpose is unchanged", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "garden.sapcloud.io/purpose", "testing")
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("maximum worker pool checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should allow a number of worker pools at the limit", func() {
+				admissionHandler.SetConfiguration(&Configuration{MaxWorkerPools: 1})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject a number of worker pools over the limit", func() {
+				admissionHandler.SetConfiguration(&Configuration{MaxWorkerPools: 1})
+
+				worker := shoot.Spec.Provider.Workers[0]
+				worker.Name = "worker-name-2"
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, worker)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("must not define more than 1 worker pools"))
+			})
+
+			It("should allow updates of shoots over the limit which do not add worker pools", func() {
+				admissionHandler.SetConfiguration(&Configuration{MaxWorkerPools: 1})
+
+				worker := shoot.Spec.Provider.Workers[0]
+				worker.Name = "worker-name-2"
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, worker)
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of shoots adding worker pools over the limit", func() {
+				admissionHandler.SetConfiguration(&Configuration{MaxWorkerPools: 1})
+
+				oldShoot := shoot.DeepCopy()
+				worker := shoot.Spec.Provider.Workers[0]
+				worker.Name = "worker-name-2"
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, worker)
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+		})
+
+		Context("cluster autoscaler expander checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			DescribeTable("expander",
+				func(expander garden.ExpanderMode, matcher types.GomegaMatcher) {
+					shoot.Spec.Kubernetes.ClusterAutoscaler = &garden.ClusterAutoscaler{Expander: &expander}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject an unknown expander", garden.ExpanderMode("price"), beBadRequest()),
+				Entry("should reject an empty expander", garden.ExpanderMode(""), beBadRequest()),
+				Entry("should allow the least-waste expander", garden.ClusterAutoscalerExpanderLeastWaste, BeNil()),
+				Entry("should allow the most-pods expander", garden.ClusterAutoscalerExpanderMostPods, BeNil()),
+				Entry("should allow the priority expander", garden.ClusterAutoscalerExpanderPriority, BeNil()),
+				Entry("should allow the random expander", garden.ClusterAutoscalerExpanderRandom, BeNil()),
+			)
+
+			It("should allow updates of shoots whose unknown expander is unchanged", func() {
+				expander := garden.ExpanderMode("unknown")
+				shoot.Spec.Kubernetes.ClusterAutoscaler = &garden.ClusterAutoscaler{Expander: &expander}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of shoots changing to an unknown expander", func() {
+				oldShoot := shoot.DeepCopy()
+				expander := garden.ExpanderMode("unknown")
+				shoot.Spec.Kubernetes.ClusterAutoscaler = &garden.ClusterAutoscaler{Expander: &expander}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+		})
+
+		Context("addon resource budget checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				shoot.Spec.Addons = &garden.Addons{
+					KubernetesDashboard: &garden.KubernetesDashboard{Addon: garden.Addon{Enabled: true}},
+					NginxIngress:        &garden.NginxIngress{Addon: garden.Addon{Enabled: true}},
+					KubeLego:            &garden.KubeLego{Addon: garden.Addon{Enabled: false}},
+				}
+			})
+
+			It("should allow addons requesting resources at the budget", func() {
+				admissionHandler.SetConfiguration(&Configuration{AddonResourceBudget: &AddonResourceBudget{CPU: resource.MustParse("150m"), Memory: resource.MustParse("150Mi")}})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject addons requesting resources over the budget", func() {
+				admissionHandler.SetConfiguration(&Configuration{AddonResourceBudget: &AddonResourceBudget{CPU: resource.MustParse("1"), Memory: resource.MustParse("128Mi")}})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("the enabled addons request cpu: 150m, memory: 150Mi in total which exceeds the budget of cpu: 1, memory: 128Mi; heaviest addons: nginx-ingress (cpu: 100m, memory: 100Mi), kubernetes-dashboard (cpu: 50m, memory: 50Mi)"))
+			})
+
+			It("should allow updates of shoots whose addons over the budget are unchanged", func() {
+				admissionHandler.SetConfiguration(&Configuration{AddonResourceBudget: &AddonResourceBudget{CPU: resource.MustParse("1"), Memory: resource.MustParse("128Mi")}})
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of shoots enabling addons over the budget", func() {
+				admissionHandler.SetConfiguration(&Configuration{AddonResourceBudget: &AddonResourceBudget{CPU: resource.MustParse("1"), Memory: resource.MustParse("128Mi")}})
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Addons.NginxIngress.Enabled = false
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+
+			It("should not limit the addons if no budget is configured", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("pinned seed provider type checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+				gcpCloudProfile := cloudProfile.DeepCopy()
+				gcpCloudProfile.Name = "gcp-profile"
+				gcpCloudProfile.Spec.Type = "gcp"
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(gcpCloudProfile)
+			})
+
+			DescribeTable("provider type of the pinned seed",
+				func(seedProviderType, seedCloudProfile string, forbidden bool) {
+					seed.Spec.Provider.Type = seedProviderType
+					seed.Spec.Cloud.Profile = seedCloudProfile
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					if forbidden {
+						Expect(apierrors.IsForbidden(err)).To(BeTrue())
+						Expect(err.Error()).To(ContainSubstring("differs from the provider type"))
+						return
+					}
+					Expect(err).NotTo(HaveOccurred())
+				},
+				Entry("should allow a seed with the same provider type", "unknown", "", false),
+				Entry("should reject a seed with another provider type", "gcp", "", true),
+				Entry("should allow a seed whose cloud profile has the same provider type", "", "profile", false),
+				Entry("should reject a seed whose cloud profile has another provider type", "", "gcp-profile", true),
+				Entry("should allow a seed without provider type", "", "", false),
+			)
+		})
+
+		Context("project scope checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			DescribeTable("cloud profile and secret binding references",
+				func(scopes []ProjectScope, forbidden bool) {
+					admissionHandler.SetConfiguration(&Configuration{ProjectScopes: scopes})
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					if forbidden {
+						Expect(apierrors.IsForbidden(err)).To(BeTrue())
+						return
+					}
+					Expect(err).NotTo(HaveOccurred())
+				},
+				Entry("should allow in-scope references", []ProjectScope{{Project: "my-project", CloudProfiles: []string{"profile", "other-profile"}, SecretBindings: []string{"my-secret"}}}, false),
+				Entry("should reject an out-of-scope cloud profile", []ProjectScope{{Project: "my-project", CloudProfiles: []string{"other-profile"}}}, true),
+				Entry("should reject an out-of-scope secret binding", []ProjectScope{{Project: "my-project", SecretBindings: []string{"other-secret"}}}, true),
+				Entry("should not restrict references if the scope lists none", []ProjectScope{{Project: "my-project"}}, false),
+				Entry("should not restrict references of projects without scope", []ProjectScope{{Project: "other-project", CloudProfiles: []string{"other-profile"}, SecretBindings: []string{"other-secret"}}}, false),
+			)
+
+			It("should allow updates of shoots whose references went out of scope", func() {
+				admissionHandler.SetConfiguration(&Configuration{ProjectScopes: []ProjectScope{{Project: "my-project", CloudProfiles: []string{"other-profile"}, SecretBindings: []string{"other-secret"}}}})
+
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates changing a reference to an out-of-scope one", func() {
+				admissionHandler.SetConfiguration(&Configuration{ProjectScopes: []ProjectScope{{Project: "my-project", SecretBindings: []string{"my-secret"}}}})
+
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.SecretBindingName = "other-secret"
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		Context("timezone consistency checks", func() {
+			var (
+				tokyo = "Asia/Tokyo"
+				attrs *annotationRecordingAttributes
+			)
+
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				shoot.Spec.Maintenance = &garden.Maintenance{
+					TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+				}
+				shoot.Spec.Hibernation = &garden.Hibernation{
+					Schedules: []garden.HibernationSchedule{{Location: &tokyo}},
+				}
+			})
+
+			admit := func() error {
+				attrs = &annotationRecordingAttributes{
+					Attributes: admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil),
+				}
+				return admissionHandler.Admit(attrs, nil)
+			}
+
+			It("should only warn about differing timezones by default", func() {
+				Expect(admit()).To(Succeed())
+				Expect(attrs.annotations).To(HaveKeyWithValue(TimezoneConflictWarningAnnotation, ContainSubstring("spec.hibernation.schedules[0].location: hibernation schedule is evaluated in location Asia/Tokyo whose UTC offset differs from the one of the maintenance time window (+01:00)")))
+			})
+
+			It("should reject differing timezones if configured", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingTimezones: true})
+
+				err := admit()
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("hibernation schedule is evaluated in location Asia/Tokyo"))
+			})
+
+			It("should evaluate schedules without location in UTC", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingTimezones: true})
+				shoot.Spec.Hibernation.Schedules = []garden.HibernationSchedule{{}}
+
+				Expect(admit()).To(beBadRequest())
+			})
+
+			It("should neither warn about nor reject matching timezones", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingTimezones: true})
+				shoot.Spec.Maintenance.TimeWindow = &garden.MaintenanceTimeWindow{Begin: "220000+0900", End: "230000+0900"}
+
+				Expect(admit()).To(Succeed())
+				Expect(attrs.annotations).To(BeEmpty())
+			})
+
+			It("should accept both the standard and the daylight saving time offset of a location", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingTimezones: true})
+				berlin := "Europe/Berlin"
+				shoot.Spec.Hibernation.Schedules = []garden.HibernationSchedule{{Location: &berlin}}
+
+				Expect(admit()).To(Succeed())
+
+				shoot.Spec.Maintenance.TimeWindow = &garden.MaintenanceTimeWindow{Begin: "220000+0200", End: "230000+0200"}
+
+				Expect(admit()).To(Succeed())
+				Expect(attrs.annotations).To(BeEmpty())
+			})
+
+			It("should not check the timezones if neither the maintenance time window nor the hibernation schedules changed", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingTimezones: true})
+				oldShoot := shoot.DeepCopy()
+				shoot.Labels = map[string]string{"foo": "bar"}
+
+				attrs = &annotationRecordingAttributes{
+					Attributes: admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil),
+				}
+
+				Expect(admissionHandler.Admit(attrs, nil)).To(Succeed())
+				Expect(attrs.annotations).To(BeEmpty())
+			})
+		})
+
+		Context("machine image auto-update checks", func() {
+			var attrs *annotationRecordingAttributes
+
+			BeforeEach(func() {
+				cloudProfile.Spec.MachineImages[0].Versions = []garden.ExpirableVersion{{Version: validShootMachineImageVersion}, {Version: "0.0.2"}}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				shoot.Spec.Provider.Workers[0].Machine.Image = &garden.ShootMachineImage{Name: validMachineImageName, Version: validShootMachineImageVersion}
+			})
+
+			admit := func(operation admission.Operation) error {
+				attrs = &annotationRecordingAttributes{
+					Attributes: admission.NewAttributesRecord(&shoot, shoot.DeepCopy(), garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", operation, false, nil),
+				}
+				return admissionHandler.Admit(attrs, nil)
+			}
+
+			It("should only warn about a pinned machine image version by default", func() {
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(attrs.annotations).To(HaveKeyWithValue(MachineImageAutoUpdateConflictWarningAnnotation, ContainSubstring("spec.provider.workers[0].machine.image.version: machine image version 0.0.1 is pinned")))
+			})
+
+			It("should reject a pinned machine image version if configured", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingMachineImageAutoUpdate: true})
+				shoot.Spec.Maintenance = &garden.Maintenance{AutoUpdate: &garden.MaintenanceAutoUpdate{MachineImageVersion: makeBoolPointer(true)}}
+
+				err := admit(admission.Create)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("machine image version 0.0.1 is pinned"))
+			})
+
+			It("should allow a pinned machine image version if it is not automatically updated", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingMachineImageAutoUpdate: true})
+				shoot.Spec.Maintenance = &garden.Maintenance{AutoUpdate: &garden.MaintenanceAutoUpdate{MachineImageVersion: makeBoolPointer(false)}}
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(attrs.annotations).To(BeEmpty())
+			})
+
+			It("should allow an automatically updated machine image without version", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingMachineImageAutoUpdate: true})
+				shoot.Spec.Provider.Workers[0].Machine.Image = nil
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(attrs.annotations).To(BeEmpty())
+			})
+
+			It("should allow the default machine image version of the cloud profile", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingMachineImageAutoUpdate: true})
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = "0.0.2"
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(attrs.annotations).To(BeEmpty())
+			})
+
+			It("should not check the machine image versions of existing shoots", func() {
+				admissionHandler.SetConfiguration(&Configuration{RejectConflictingMachineImageAutoUpdate: true})
+
+				Expect(admit(admission.Update)).To(Succeed())
+			})
+		})
+
+		Context("DNS provider type checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				admissionHandler.SetConfiguration(&Configuration{DNSProviderTypes: []string{"aws-route53", "google-clouddns"}})
+			})
+
+			It("should reject an unknown DNS provider type", func() {
+				providerType := "unknown-dns"
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &providerType}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring(`spec.dns.providers[0].type: DNS provider type "unknown-dns" is not registered (registered types: aws-route53, google-clouddns)`))
+			})
+
+			It("should allow a registered DNS provider type", func() {
+				providerType := "google-clouddns"
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &providerType}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow the unmanaged DNS provider type", func() {
+				providerType := garden.DNSUnmanaged
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &providerType}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates of shoots whose unknown DNS provider type is unchanged", func() {
+				providerType := "unknown-dns"
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &providerType}}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of shoots adding an unknown DNS provider type", func() {
+				oldShoot := shoot.DeepCopy()
+				providerType := "unknown-dns"
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &providerType}}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("is not registered"))
+			})
+
+			It("should not check the DNS provider types if no types are registered", func() {
+				admissionHandler.SetConfiguration(&Configuration{})
+				providerType := "unknown-dns"
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{Type: &providerType}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("node CIDR mask size checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				pods := "100.96.0.0/22"
+				shoot.Spec.Networking.Pods = &pods
+			})
+
+			DescribeTable("per-node ranges",
+				func(nodeCIDRMaskSize *int, maximum int, matcher types.GomegaMatcher) {
+					shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{NodeCIDRMaskSize: nodeCIDRMaskSize}
+					shoot.Spec.Provider.Workers[0].Maximum = maximum
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject a pod network exhausted by the maximum number of nodes", nil, 5, beBadRequest()),
+				Entry("should reject a per-node range larger than the pod network", intPtr(20), 1, beBadRequest()),
+				Entry("should allow a pod network providing a range for every node", nil, 4, BeNil()),
+				Entry("should allow smaller per-node ranges for more nodes", intPtr(25), 8, BeNil()),
+			)
+
+			It("should allow updates of shoots whose exhausted pod network is unchanged", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 5
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Minimum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of shoots increasing the maximum number of nodes beyond the pod network", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum = 5
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+		})
+
+		Context("pod network capacity checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+				pods := "100.96.0.0/16"
+				shoot.Spec.Networking.Pods = &pods
+			})
+
+			DescribeTable("maximum number of pods",
+				func(shootMaxPods, workerMaxPods *int32, maximum int, matcher types.GomegaMatcher) {
+					shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{MaxPods: shootMaxPods}
+					shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{Kubelet: &garden.KubeletConfig{MaxPods: workerMaxPods}}
+					shoot.Spec.Provider.Workers[0].Maximum = maximum
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject a pod network too small for the pods of all nodes", makeInt32Pointer(300), nil, 250, beBadRequest()),
+				Entry("should reject a pod network too small for the pods configured for the worker pool", makeInt32Pointer(250), makeInt32Pointer(300), 250, beBadRequest()),
+				Entry("should allow a pod network providing an address for the pods of all nodes", makeInt32Pointer(250), nil, 250, BeNil()),
+				Entry("should allow a pod network providing an address for the default number of pods of all nodes", nil, nil, 256, BeNil()),
+			)
+
+			It("should allow updates of shoots whose exhausted pod network is unchanged", func() {
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{MaxPods: makeInt32Pointer(300)}
+				shoot.Spec.Provider.Workers[0].Maximum = 250
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Minimum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of shoots increasing the maximum number of pods beyond the pod network", func() {
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{MaxPods: makeInt32Pointer(250)}
+				shoot.Spec.Provider.Workers[0].Maximum = 250
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Kubernetes.Kubelet.MaxPods = makeInt32Pointer(300)
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+				Expect(err.Error()).To(ContainSubstring("only provides 65536 address(es)"))
+			})
+		})
+
+		Context("feature gate consistency checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			DescribeTable("kubelet and kube-apiserver feature gates",
+				func(apiServerFeatureGates, kubeletFeatureGates, workerKubeletFeatureGates map[string]bool, matcher types.GomegaMatcher) {
+					shoot.Spec.Kubernetes.KubeAPIServer = &garden.KubeAPIServerConfig{KubernetesConfig: garden.KubernetesConfig{FeatureGates: apiServerFeatureGates}}
+					shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{KubernetesConfig: garden.KubernetesConfig{FeatureGates: kubeletFeatureGates}}
+					shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{Kubelet: &garden.KubeletConfig{KubernetesConfig: garden.KubernetesConfig{FeatureGates: workerKubeletFeatureGates}}}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject a feature gate enabled for the kube-apiserver but disabled for the kubelet", map[string]bool{"BlockVolume": true}, map[string]bool{"BlockVolume": false}, nil, beBadRequest()),
+				Entry("should reject a feature gate disabled for the kube-apiserver but enabled for a worker kubelet", map[string]bool{"LocalStorageCapacityIsolation": false}, nil, map[string]bool{"LocalStorageCapacityIsolation": true}, beBadRequest()),
+				Entry("should allow a feature gate set consistently", map[string]bool{"BlockVolume": true}, map[string]bool{"BlockVolume": true}, map[string]bool{"BlockVolume": true}, BeNil()),
+				Entry("should allow a feature gate set for the kube-apiserver only", map[string]bool{"BlockVolume": true}, nil, nil, BeNil()),
+				Entry("should allow other feature gates set differently", map[string]bool{"PodPriority": true}, map[string]bool{"PodPriority": false}, nil, BeNil()),
+			)
+
+			It("should allow updates of shoots with unchanged inconsistent feature gates", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &garden.KubeAPIServerConfig{KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"BlockVolume": true}}}
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"BlockVolume": false}}}
+
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates introducing inconsistent feature gates", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &garden.KubeAPIServerConfig{KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"BlockVolume": true}}}
+
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{Kubelet: &garden.KubeletConfig{KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"BlockVolume": false}}}}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+		})
+
+		Context("storage feature gate checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should reject a storage feature gate not supported by the Kubernetes version", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"CSIMigration": true}},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.kubernetes.kubeControllerManager.featureGates[CSIMigration]: feature gate CSIMigration requires at least Kubernetes version 1.14 (found: 1.6.4)"))
+			})
+
+			It("should reject a storage feature gate of a worker kubelet not supported by the Kubernetes version", func() {
+				shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{
+					Kubelet: &garden.KubeletConfig{
+						KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"CSIInlineVolume": true}},
+					},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("feature gate CSIInlineVolume requires at least Kubernetes version 1.15"))
+			})
+
+			It("should allow a storage feature gate supported by the Kubernetes version", func() {
+				cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, garden.ExpirableVersion{Version: "1.15.2"})
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Update(&cloudProfile)
+				shoot.Spec.Kubernetes.Version = "1.15.2"
+				shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"CSIMigration": true, "CSIMigrationAzureDisk": true}},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow a disabled storage feature gate", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"CSIMigration": false}},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("kubelet swap checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should reject enabling swap for the kubelet if the Kubernetes version does not support it", func() {
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"NodeSwap": true}},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.kubernetes.kubelet.featureGates[NodeSwap]: kubelet swap requires at least Kubernetes version 1.22 (found: 1.6.4)"))
+			})
+
+			It("should reject enabling swap for a worker kubelet if the Kubernetes version does not support it", func() {
+				shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{
+					Kubelet: &garden.KubeletConfig{
+						KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"NodeSwap": true}},
+					},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.provider.workers[0].kubernetes.kubelet.featureGates[NodeSwap]: kubelet swap requires at least Kubernetes version 1.22"))
+			})
+
+			It("should allow enabling swap for the kubelet if the Kubernetes version supports it", func() {
+				cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, garden.ExpirableVersion{Version: "1.22.0"})
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Update(&cloudProfile)
+				shoot.Spec.Kubernetes.Version = "1.22.0"
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"NodeSwap": true}},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow a disabled swap feature gate", func() {
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"NodeSwap": false}},
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("worker volume checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			DescribeTable("volume",
+				func(volume *garden.Volume, matcher types.GomegaMatcher) {
+					shoot.Spec.Provider.Workers[0].Volume = volume
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject a volume type without size", &garden.Volume{Type: "volume-type-1"}, beBadRequest()),
+				Entry("should reject a volume type with an unparseable size", &garden.Volume{Type: "volume-type-1", Size: "forty"}, beBadRequest()),
+				Entry("should allow a complete volume", &garden.Volume{Type: "volume-type-1", Size: "40Gi"}, BeNil()),
+				Entry("should allow no volume", nil, BeNil()),
+			)
+
+			It("should allow updates of worker pools whose incomplete volume is unchanged", func() {
+				shoot.Spec.Provider.Workers[0].Volume = &garden.Volume{Type: "volume-type-1"}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of worker pools changing to an incomplete volume", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Volume = &garden.Volume{Type: "volume-type-1"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+		})
+
+		Context("worker node label checks", func() {
+			BeforeEach(func() {
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			DescribeTable("node labels",
+				func(labels map[string]string, matcher types.GomegaMatcher) {
+					shoot.Spec.Provider.Workers[0].Labels = labels
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(matcher)
+				},
+				Entry("should reject a label in the kubernetes.io namespace", map[string]string{"kubernetes.io/role": "worker"}, beBadRequest()),
+				Entry("should reject a label in a subdomain of the kubernetes.io namespace", map[string]string{"node-role.kubernetes.io/worker": ""}, beBadRequest()),
+				Entry("should reject a label in the k8s.io namespace", map[string]string{"k8s.io/foo": "bar"}, beBadRequest()),
+				Entry("should allow a label in the node.kubernetes.io namespace", map[string]string{"node.kubernetes.io/pool": "foo"}, BeNil()),
+				Entry("should allow an explicitly allowed reserved label", map[string]string{"topology.kubernetes.io/zone": "europe-a"}, BeNil()),
+				Entry("should allow a custom label", map[string]string{"example.com/pool": "foo", "pool": "foo"}, BeNil()),
+			)
+
+			It("should allow updates of worker pools whose reserved label is unchanged", func() {
+				shoot.Spec.Provider.Workers[0].Labels = map[string]string{"kubernetes.io/role": "worker"}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject updates of worker pools adding a reserved label", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Labels = map[string]string{"kubernetes.io/role": "worker"}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(beBadRequest())
+			})
+		})
+
+		Context("region zone checks", func() {
+			BeforeEach(func() {
+				cloudProfile.Spec.Type = "aws"
+				shoot.Spec.Provider.Type = "aws"
+				shoot.Spec.Cloud.AWS = &garden.AWSCloud{}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should reject because the region of a zoned provider does not declare zones", func() {
+				cloudProfile.Spec.Regions[0].Zones = nil
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`region "europe" of cloud profile "profile" does not declare any zones`))
+			})
+
+			It("should not reject updates of shoots in a region of a zoned provider which does not declare zones", func() {
+				cloudProfile.Spec.Regions[0].Zones = nil
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum++
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(apierrors.IsBadRequest(err)).To(BeFalse())
+			})
+
+			It("should not reject because the region of a zoned provider declares zones", func() {
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(apierrors.IsBadRequest(err)).To(BeFalse())
+			})
+
+			It("should not reject a zoneless region of a provider without zones", func() {
+				cloudProfile.Spec.Type = "unknown"
+				shoot.Spec.Provider.Type = "unknown"
+				cloudProfile.Spec.Regions[0].Zones = nil
+				shoot.Spec.Provider.Workers[0].Zones = nil
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("#LoadConfiguration", func() {
+		It("should return an empty configuration if none is given", func() {
+			configuration, err := LoadConfiguration(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configuration).To(Equal(&Configuration{}))
+		})
+
+		It("should load the minimum machine image versions", func() {
+			configuration, err := LoadConfiguration(strings.NewReader(`
+minimumMachineImageVersions:
+- name: coreos
+  kubernetesVersion: "1.15"
+  version: 2135.6.0
+`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configuration.MinimumMachineImageVersions).To(ConsistOf(MinimumMachineImageVersion{Name: "coreos", KubernetesVersion: "1.15", Version: "2135.6.0"}))
+		})
+
+		It("should fail for an invalid minimum version", func() {
+			_, err := LoadConfiguration(strings.NewReader(`
+minimumMachineImageVersions:
+- name: coreos
+  kubernetesVersion: "1.15"
+  version: foo
+`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for a negative maximum number of worker pools", func() {
+			_, err := LoadConfiguration(strings.NewReader(`maxWorkerPools: -1`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the addon resource budget is negative", func() {
+			_, err := LoadConfiguration(strings.NewReader(`
+addonResourceBudget:
+  cpu: -100m
+  memory: 1Gi
+`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if a project has multiple scopes", func() {
+			_, err := LoadConfiguration(strings.NewReader(`
+projectScopes:
+- project: my-project
+  cloudProfiles:
+  - profile
+- project: my-project
+  secretBindings:
+  - my-secret
+`))
+
+			Expect(err).To(MatchError(ContainSubstring(`duplicate scope for project "my-project"`)))
+		})
+	})
+})
+
+func makeInt32Pointer(v int32) *int32 {
+	return &v
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func makeBoolPointer(v bool) *bool {
+	return &v
+}
+
+func strPtr(v string) *string {
+	return &v
+}
+
+func intOrStrPtr(v intstr.IntOrString) *intstr.IntOrString {
+	return &v
+}
+
+func beBadRequest() types.GomegaMatcher {
+	return WithTransform(apierrors.IsBadRequest, BeTrue())
+}
+
+// annotationRecordingAttributes records the audit annotations added by the admission plugin.
+type annotationRecordingAttributes struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotationRecordingAttributes) AddAnnotation(key, value string) error {
+	if a.annotations == nil {
+		a.annotations = map[string]string{}
+	}
+	a.annotations[key] = value
+	return a.Attributes.AddAnnotation(key, value)
+}
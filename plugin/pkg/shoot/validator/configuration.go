@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/Masterminds/semver"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Configuration contains the configuration of the ShootValidator admission plugin.
+type Configuration struct {
+	// MinimumMachineImageVersions is a list of minimum machine image versions that worker pools must use for a given
+	// Kubernetes minor version.
+	MinimumMachineImageVersions []MinimumMachineImageVersion `json:"minimumMachineImageVersions,omitempty"`
+	// MaxWorkerPools is the maximum number of worker pools a shoot may define. Zero means that the number of worker
+	// pools is not limited. Updates of shoots exceeding the limit are only rejected if they add worker pools.
+	MaxWorkerPools int `json:"maxWorkerPools,omitempty"`
+	// DNSProviderTypes is the list of DNS provider types for which an extension is registered. Shoots must only use
+	// these (or the `unmanaged`) provider types. If empty, the provider types are not checked.
+	DNSProviderTypes []string `json:"dnsProviderTypes,omitempty"`
+	// RejectConflictingTimezones defines whether shoots whose hibernation schedules use a different timezone than
+	// their maintenance time window are rejected. If not set, such shoots are only annotated with a warning.
+	RejectConflictingTimezones bool `json:"rejectConflictingTimezones,omitempty"`
+	// RejectConflictingMachineImageAutoUpdate defines whether shoots pinning the machine image version of a worker pool
+	// while the machine image version may be automatically updated are rejected. If not set, such shoots are only
+	// annotated with a warning.
+	RejectConflictingMachineImageAutoUpdate bool `json:"rejectConflictingMachineImageAutoUpdate,omitempty"`
+	// AddonResourceBudget is the maximum amount of resources the enabled addons of a shoot may request in total. If not
+	// set, the resource requests of the addons are not limited.
+	AddonResourceBudget *AddonResourceBudget `json:"addonResourceBudget,omitempty"`
+	// ProjectScopes restricts the cloud profiles and secret bindings the shoots of the listed projects may reference.
+	// The shoots of projects without a scope are not restricted. Only new or changed references are checked.
+	ProjectScopes []ProjectScope `json:"projectScopes,omitempty"`
+}
+
+// ProjectScope defines the cloud profiles and secret bindings the shoots of a project may reference.
+type ProjectScope struct {
+	// Project is the name of the project.
+	Project string `json:"project"`
+	// CloudProfiles is the list of names of the cloud profiles the shoots of the project may reference. If empty, the
+	// cloud profiles are not restricted.
+	CloudProfiles []string `json:"cloudProfiles,omitempty"`
+	// SecretBindings is the list of names of the secret bindings the shoots of the project may reference. If empty, the
+	// secret bindings are not restricted.
+	SecretBindings []string `json:"secretBindings,omitempty"`
+}
+
+// AddonResourceBudget defines the maximum amount of resources the addons of a shoot may request in total.
+type AddonResourceBudget struct {
+	// CPU is the maximum amount of CPU the addons may request.
+	CPU resource.Quantity `json:"cpu"`
+	// Memory is the maximum amount of memory the addons may request.
+	Memory resource.Quantity `json:"memory"`
+}
+
+// MinimumMachineImageVersion defines the minimum version of a machine image for a Kubernetes minor version.
+type MinimumMachineImageVersion struct {
+	// Name is the name of the machine image.
+	Name string `json:"name"`
+	// KubernetesVersion is the Kubernetes minor version (e.g. `1.15`) for which the minimum version is required.
+	KubernetesVersion string `json:"kubernetesVersion"`
+	// Version is the minimum version of the machine image.
+	Version string `json:"version"`
+}
+
+// LoadConfiguration reads the plugin configuration from the given reader. If no configuration is provided then an
+// empty configuration is returned.
+func LoadConfiguration(config io.Reader) (*Configuration, error) {
+	configuration := &Configuration{}
+	if config == nil {
+		return configuration, nil
+	}
+
+	if err := yaml.NewYAMLOrJSONDecoder(config, 4096).Decode(configuration); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("could not decode configuration of admission plugin %s: %v", PluginName, err)
+	}
+
+	if configuration.MaxWorkerPools < 0 {
+		return nil, fmt.Errorf("invalid maximum number of worker pools %d: must not be negative", configuration.MaxWorkerPools)
+	}
+
+	if budget := configuration.AddonResourceBudget; budget != nil && (budget.CPU.Sign() < 0 || budget.Memory.Sign() < 0) {
+		return nil, fmt.Errorf("invalid addon resource budget (cpu: %s, memory: %s): must not be negative", budget.CPU.String(), budget.Memory.String())
+	}
+
+	projects := make(map[string]bool, len(configuration.ProjectScopes))
+	for _, scope := range configuration.ProjectScopes {
+		if len(scope.Project) == 0 {
+			return nil, fmt.Errorf("invalid project scope: the project name must not be empty")
+		}
+		if projects[scope.Project] {
+			return nil, fmt.Errorf("invalid project scope: duplicate scope for project %q", scope.Project)
+		}
+		projects[scope.Project] = true
+	}
+
+	for _, minimum := range configuration.MinimumMachineImageVersions {
+		if _, err := semver.NewVersion(minimum.Version); err != nil {
+			return nil, fmt.Errorf("invalid minimum version %q for machine image %q: %v", minimum.Version, minimum.Name, err)
+		}
+		if _, err := semver.NewVersion(minimum.KubernetesVersion); err != nil {
+			return nil, fmt.Errorf("invalid Kubernetes version %q for machine image %q: %v", minimum.KubernetesVersion, minimum.Name, err)
+		}
+	}
+
+	return configuration, nil
+}
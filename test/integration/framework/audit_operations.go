@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// AuditEvent identifies an audited request to the API server of the shoot.
+type AuditEvent struct {
+	// Verb is the verb of the request, e.g. `create`.
+	Verb string
+	// Resource is the resource of the request, e.g. `configmaps`.
+	Resource string
+	// Namespace is the namespace of the requested object.
+	Namespace string
+	// Name is the name of the requested object.
+	Name string
+}
+
+// AuditLogBackend is the backend the audit logs of the shoot are delivered to.
+type AuditLogBackend interface {
+	// ContainsAuditEvent returns true if the backend has received an audit log entry for the given event.
+	ContainsAuditEvent(ctx context.Context, event AuditEvent) (bool, error)
+}
+
+// AssertAuditLogsDelivered creates (and deletes again) a config map in the shoot to trigger an audited request and
+// waits until the given backend has received the audit log entry for it. It returns an error if the entry is not
+// delivered within the given timeout.
+func (o *GardenerTestOperation) AssertAuditLogsDelivered(ctx context.Context, backend AuditLogBackend, timeout time.Duration) error {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("audit-log-probe-%s", rand.String(5)),
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	if err := o.ShootClient.Client().Create(ctx, configMap); err != nil {
+		return fmt.Errorf("could not create config map %s/%s to trigger an audit log entry: %v", configMap.Namespace, configMap.Name, err)
+	}
+	defer func() {
+		if err := o.ShootClient.Client().Delete(ctx, configMap); err != nil && !apierrors.IsNotFound(err) {
+			o.Logger.Errorf("Could not delete config map %s/%s: %v", configMap.Namespace, configMap.Name, err)
+		}
+	}()
+
+	event := AuditEvent{
+		Verb:      "create",
+		Resource:  "configmaps",
+		Namespace: configMap.Namespace,
+		Name:      configMap.Name,
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		delivered, err := backend.ContainsAuditEvent(ctx, event)
+		if err != nil {
+			return retry.MinorError(fmt.Errorf("could not query the audit log backend: %v", err))
+		}
+		if !delivered {
+			o.Logger.Infof("Waiting for the audit log entry of %s %s %s/%s to be delivered", event.Verb, event.Resource, event.Namespace, event.Name)
+			return retry.MinorError(fmt.Errorf("audit log entry of %s %s %s/%s has not been delivered to the backend", event.Verb, event.Resource, event.Namespace, event.Name))
+		}
+
+		o.Logger.Infof("Audit log entry of %s %s %s/%s was delivered!", event.Verb, event.Resource, event.Namespace, event.Name)
+		return retry.Ok()
+	})
+}
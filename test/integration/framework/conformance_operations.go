@@ -0,0 +1,220 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// conformanceSmokeImage is the image of the pods created by the conformance smoke test.
+	conformanceSmokeImage = "busybox:1.31"
+	// conformanceSmokeName is the name of the objects created by the conformance smoke test.
+	conformanceSmokeName = "conformance-smoke"
+	// conformanceSmokeVolumeName is the name of the volume claim and its consumer created by the conformance smoke test.
+	conformanceSmokeVolumeName = conformanceSmokeName + "-volume"
+	// conformanceSmokeContainer is the name of the container of the pods created by the conformance smoke test.
+	conformanceSmokeContainer = "smoke"
+
+	// ConformanceSmokeCheckPodScheduling checks that a pod is scheduled and becomes ready.
+	ConformanceSmokeCheckPodScheduling = "pod-scheduling"
+	// ConformanceSmokeCheckServiceDNS checks that the `kubernetes` service can be resolved from within a pod.
+	ConformanceSmokeCheckServiceDNS = "service-dns"
+	// ConformanceSmokeCheckVolumeClaimBinding checks that a persistent volume claim of the default storage class is
+	// bound once it is consumed by a pod.
+	ConformanceSmokeCheckVolumeClaimBinding = "pvc-binding"
+)
+
+// ConformanceSmokeCheck is the result of a single check of the conformance smoke test.
+type ConformanceSmokeCheck struct {
+	// Name is the name of the check.
+	Name string
+	// Err is the reason why the check failed, or nil if it passed.
+	Err error
+}
+
+// ConformanceSmokeReport aggregates the results of the checks of the conformance smoke test.
+type ConformanceSmokeReport struct {
+	// Checks are the results of the executed checks in the order they were executed.
+	Checks []ConformanceSmokeCheck
+}
+
+// Passed returns whether all checks of the report passed.
+func (r *ConformanceSmokeReport) Passed() bool {
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a summary of the results of all checks of the report.
+func (r *ConformanceSmokeReport) String() string {
+	results := make([]string, 0, len(r.Checks))
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			results = append(results, fmt.Sprintf("%s: failed (%v)", check.Name, check.Err))
+			continue
+		}
+		results = append(results, fmt.Sprintf("%s: passed", check.Name))
+	}
+	return strings.Join(results, "; ")
+}
+
+func (r *ConformanceSmokeReport) add(name string, err error) {
+	r.Checks = append(r.Checks, ConformanceSmokeCheck{Name: name, Err: err})
+}
+
+// RunConformanceSmoke runs a small set of conformance checks against the shoot: it checks that a pod is scheduled and
+// becomes ready, that the `kubernetes` service can be resolved from within this pod and that a persistent volume claim
+// of the default storage class is bound once it is consumed by a pod. Each check must pass within the given timeout.
+// It returns a report of all checks, and an error summarizing the report if any check failed. The created objects are
+// deleted afterwards.
+func (o *GardenerTestOperation) RunConformanceSmoke(ctx context.Context, timeout time.Duration) (*ConformanceSmokeReport, error) {
+	var (
+		report = &ConformanceSmokeReport{}
+
+		pod         = newConformanceSmokePod(conformanceSmokeName)
+		volumePod   = newConformanceSmokePod(conformanceSmokeVolumeName)
+		volumeClaim = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: conformanceSmokeVolumeName},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+	)
+
+	volumePod.Spec.Volumes = []corev1.Volume{{
+		Name: "data",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: conformanceSmokeVolumeName},
+		},
+	}}
+	volumePod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}
+
+	defer func() {
+		for _, obj := range []runtime.Object{volumePod, volumeClaim, pod} {
+			if err := o.ShootClient.Client().Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				o.Logger.Errorf("Could not delete %T of the conformance smoke test: %v", obj, err)
+			}
+		}
+	}()
+
+	podErr := o.checkConformanceSmokePodScheduling(ctx, pod, timeout)
+	report.add(ConformanceSmokeCheckPodScheduling, podErr)
+
+	if podErr != nil {
+		report.add(ConformanceSmokeCheckServiceDNS, fmt.Errorf("no ready pod to resolve the service from"))
+	} else {
+		report.add(ConformanceSmokeCheckServiceDNS, o.checkConformanceSmokeServiceDNS(ctx, pod, timeout))
+	}
+
+	report.add(ConformanceSmokeCheckVolumeClaimBinding, o.checkConformanceSmokeVolumeClaimBinding(ctx, volumeClaim, volumePod, timeout))
+
+	if !report.Passed() {
+		return report, fmt.Errorf("conformance smoke test of shoot %s failed: %s", o.Shoot.Name, report.String())
+	}
+	o.Logger.Infof("Conformance smoke test of shoot %s passed: %s", o.Shoot.Name, report.String())
+	return report, nil
+}
+
+// checkConformanceSmokePodScheduling creates the given pod and waits until it is ready.
+func (o *GardenerTestOperation) checkConformanceSmokePodScheduling(ctx context.Context, pod *corev1.Pod, timeout time.Duration) error {
+	if err := o.ShootClient.Client().Create(ctx, pod); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		current := &corev1.Pod{}
+		if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: pod.Name}, current); err != nil {
+			return retry.SevereError(err)
+		}
+		if !health.IsPodReady(current) {
+			o.Logger.Infof("Waiting for pod %s/%s to be ready", pod.Namespace, pod.Name)
+			return retry.MinorError(fmt.Errorf("pod %s/%s is not ready", pod.Namespace, pod.Name))
+		}
+		return retry.Ok()
+	})
+}
+
+// checkConformanceSmokeServiceDNS waits until the `kubernetes` service can be resolved from within the given pod.
+func (o *GardenerTestOperation) checkConformanceSmokeServiceDNS(ctx context.Context, pod *corev1.Pod, timeout time.Duration) error {
+	executor := o.PodExecutor
+	if executor == nil {
+		executor = kubernetes.NewPodExecutor(o.ShootClient.RESTConfig())
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		if _, err := executor.Execute(ctx, pod.Namespace, pod.Name, conformanceSmokeContainer, "nslookup kubernetes.default"); err != nil {
+			o.Logger.Infof("Waiting for service kubernetes.default to be resolvable from pod %s/%s", pod.Namespace, pod.Name)
+			return retry.MinorError(fmt.Errorf("service kubernetes.default cannot be resolved from pod %s/%s: %v", pod.Namespace, pod.Name, err))
+		}
+		return retry.Ok()
+	})
+}
+
+// checkConformanceSmokeVolumeClaimBinding creates the given volume claim and its consumer and waits until the claim
+// is bound.
+func (o *GardenerTestOperation) checkConformanceSmokeVolumeClaimBinding(ctx context.Context, volumeClaim *corev1.PersistentVolumeClaim, consumer *corev1.Pod, timeout time.Duration) error {
+	for _, obj := range []runtime.Object{volumeClaim, consumer} {
+		if err := o.ShootClient.Client().Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		current := &corev1.PersistentVolumeClaim{}
+		if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Namespace: volumeClaim.Namespace, Name: volumeClaim.Name}, current); err != nil {
+			return retry.SevereError(err)
+		}
+		if current.Status.Phase != corev1.ClaimBound {
+			o.Logger.Infof("Waiting for persistent volume claim %s/%s to be bound", volumeClaim.Namespace, volumeClaim.Name)
+			return retry.MinorError(fmt.Errorf("persistent volume claim %s/%s is not bound", volumeClaim.Namespace, volumeClaim.Name))
+		}
+		return retry.Ok()
+	})
+}
+
+// newConformanceSmokePod returns a long-running pod with the given name for the conformance smoke test.
+func newConformanceSmokePod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    conformanceSmokeContainer,
+				Image:   conformanceSmokeImage,
+				Command: []string{"sleep", "3600"},
+			}},
+		},
+	}
+}
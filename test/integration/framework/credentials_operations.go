@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RotateKubeconfigCredentialsAndWait triggers the rotation of the kubeconfig credentials of the shoot by annotating it
+// with the `rotate-kubeconfig-credentials` operation. It waits until the Gardener has removed the annotation again and
+// the shoot has been reconciled successfully afterwards. It returns an error containing the last observed status if
+// the rotation does not complete within the given timeout.
+func (o *GardenerTestOperation) RotateKubeconfigCredentialsAndWait(ctx context.Context, timeout time.Duration) error {
+	shoot := &gardenv1beta1.Shoot{}
+	if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: o.Shoot.Namespace, Name: o.Shoot.Name}, shoot); err != nil {
+		return err
+	}
+
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootOperation, common.ShootOperationRotateKubeconfigCredentials)
+	if err := o.GardenClient.Client().Update(ctx, shoot); err != nil {
+		return err
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		shoot := &gardenv1beta1.Shoot{}
+		if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: o.Shoot.Namespace, Name: o.Shoot.Name}, shoot); err != nil {
+			return retry.MinorError(err)
+		}
+
+		if shoot.Annotations[common.ShootOperation] == common.ShootOperationRotateKubeconfigCredentials {
+			o.Logger.Infof("Waiting for the rotation of the kubeconfig credentials of shoot %s to be picked up", o.Shoot.Name)
+			return retry.MinorError(fmt.Errorf("rotation of the kubeconfig credentials of shoot %s has not been picked up yet", o.Shoot.Name))
+		}
+		if !ShootCreationCompleted(shoot) {
+			o.Logger.Infof("Waiting for shoot %s to be reconciled after the rotation of its kubeconfig credentials", o.Shoot.Name)
+			return retry.MinorError(fmt.Errorf("shoot %s has not been reconciled successfully after the rotation of its kubeconfig credentials (%s)", o.Shoot.Name, shootStatusSummary(shoot)))
+		}
+
+		o.Shoot = shoot
+		o.Logger.Infof("Kubeconfig credentials of shoot %s were rotated successfully!", o.Shoot.Name)
+		return retry.Ok()
+	})
+}
+
+// shootStatusSummary returns a short description of the last operation and the unhealthy conditions of the shoot.
+func shootStatusSummary(shoot *gardenv1beta1.Shoot) string {
+	summary := "no last operation"
+	if lastOperation := shoot.Status.LastOperation; lastOperation != nil {
+		summary = fmt.Sprintf("last operation: %s %s: %s", lastOperation.Type, lastOperation.State, lastOperation.Description)
+	}
+
+	for _, condition := range shoot.Status.Conditions {
+		if condition.Status != gardencorev1alpha1.ConditionTrue {
+			summary += fmt.Sprintf(", condition %s: %s", condition.Type, condition.Status)
+		}
+	}
+	return summary
+}
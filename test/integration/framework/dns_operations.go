@@ -0,0 +1,66 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// dnsPollInterval is the interval in which DNS records are resolved while waiting for them to be created.
+const dnsPollInterval = 5 * time.Second
+
+// AssertDNSRecordExists resolves the given host until it points to the expected target or the timeout is reached.
+// The target is either an IP address the host resolves to or the canonical name of the host.
+func (o *GardenerTestOperation) AssertDNSRecordExists(ctx context.Context, host, expectedTarget string, timeout time.Duration) error {
+	resolver := o.DNSResolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	return retry.UntilTimeout(ctx, dnsPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		if net.ParseIP(expectedTarget) == nil {
+			cname, err := resolver.LookupCNAME(ctx, host)
+			if err != nil {
+				o.Logger.Infof("Waiting for DNS record %s to be resolvable: %v", host, err)
+				return retry.MinorError(fmt.Errorf("could not resolve canonical name of %s: %v", host, err))
+			}
+			if !strings.EqualFold(strings.TrimSuffix(cname, "."), strings.TrimSuffix(expectedTarget, ".")) {
+				o.Logger.Infof("Waiting for DNS record %s to point to %s (currently %s)", host, expectedTarget, cname)
+				return retry.MinorError(fmt.Errorf("DNS record %s points to %s instead of %s", host, cname, expectedTarget))
+			}
+			return retry.Ok()
+		}
+
+		addresses, err := resolver.LookupHost(ctx, host)
+		if err != nil {
+			o.Logger.Infof("Waiting for DNS record %s to be resolvable: %v", host, err)
+			return retry.MinorError(fmt.Errorf("could not resolve %s: %v", host, err))
+		}
+		for _, address := range addresses {
+			if address == expectedTarget {
+				return retry.Ok()
+			}
+		}
+
+		o.Logger.Infof("Waiting for DNS record %s to point to %s (currently %v)", host, expectedTarget, addresses)
+		return retry.MinorError(fmt.Errorf("DNS record %s resolves to %v instead of %s", host, addresses, expectedTarget))
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// etcdMainPodName is the name of the (only) pod of the main etcd of a shoot.
+	etcdMainPodName = v1alpha1constants.StatefulSetNameETCDMain + "-0"
+	// etcdMainVolumeClaimName is the name of the persistent volume claim holding the data of the main etcd of a shoot.
+	etcdMainVolumeClaimName = "main-etcd-" + etcdMainPodName
+)
+
+// AssertEtcdBackupExists waits until the BackupEntry of the shoot has been reconciled successfully in the seed, i.e.
+// the entry for the etcd backups of the shoot exists in the backup bucket configured for the seed.
+func (o *GardenerTestOperation) AssertEtcdBackupExists(ctx context.Context, timeout time.Duration) error {
+	if o.Seed.Spec.Backup == nil {
+		return fmt.Errorf("seed %s has no backup configured, hence no etcd backups are taken for shoot %s", o.Seed.Name, o.Shoot.Name)
+	}
+
+	name := common.GenerateBackupEntryName(o.ShootSeedNamespace(), o.Shoot.Status.UID)
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		backupEntry := &extensionsv1alpha1.BackupEntry{}
+		if err := o.SeedClient.Client().Get(ctx, client.ObjectKey{Name: name}, backupEntry); err != nil {
+			if apierrors.IsNotFound(err) {
+				o.Logger.Infof("Waiting for backup entry %s to be created", name)
+				return retry.MinorError(fmt.Errorf("backup entry %s does not exist in seed %s", name, o.Seed.Name))
+			}
+			return retry.SevereError(err)
+		}
+
+		if err := health.CheckExtensionObject(backupEntry); err != nil {
+			o.Logger.Infof("Waiting for backup entry %s to be ready", name)
+			return retry.MinorError(fmt.Errorf("backup entry %s is not ready: %v", name, err))
+		}
+
+		return retry.Ok()
+	})
+}
+
+// TriggerRestoreAndWait deletes the data volume and the pod of the main etcd of the shoot in the seed, forcing the
+// etcd to restore its data from the latest backup when it is recreated. It waits until the recreated etcd is ready.
+func (o *GardenerTestOperation) TriggerRestoreAndWait(ctx context.Context, timeout time.Duration) error {
+	var (
+		namespace = o.ShootSeedNamespace()
+		pod       = &corev1.Pod{}
+	)
+
+	if err := o.SeedClient.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: etcdMainPodName}, pod); err != nil {
+		return fmt.Errorf("could not get etcd pod %s/%s: %v", namespace, etcdMainPodName, err)
+	}
+	oldUID := pod.UID
+
+	volumeClaim := &corev1.PersistentVolumeClaim{}
+	volumeClaim.Namespace, volumeClaim.Name = namespace, etcdMainVolumeClaimName
+	if err := o.SeedClient.Client().Delete(ctx, volumeClaim); err != nil {
+		return fmt.Errorf("could not delete etcd volume claim %s/%s: %v", namespace, etcdMainVolumeClaimName, err)
+	}
+	if err := o.SeedClient.Client().Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("could not delete etcd pod %s/%s: %v", namespace, etcdMainPodName, err)
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		if err := o.checkEtcdRestored(ctx, namespace, oldUID); err != nil {
+			o.Logger.Infof("Waiting for etcd %s/%s to be restored", namespace, v1alpha1constants.StatefulSetNameETCDMain)
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	})
+}
+
+// checkEtcdRestored checks that the pod of the main etcd has been recreated and that the etcd is ready again.
+func (o *GardenerTestOperation) checkEtcdRestored(ctx context.Context, namespace string, oldUID types.UID) error {
+	pod := &corev1.Pod{}
+	if err := o.SeedClient.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: etcdMainPodName}, pod); err != nil {
+		return fmt.Errorf("etcd pod %s/%s is not available: %v", namespace, etcdMainPodName, err)
+	}
+	if pod.UID == oldUID {
+		return fmt.Errorf("etcd pod %s/%s has not been recreated yet", namespace, etcdMainPodName)
+	}
+	if !health.IsPodReady(pod) {
+		return fmt.Errorf("etcd pod %s/%s is not ready", namespace, etcdMainPodName)
+	}
+
+	statefulSet := &appsv1.StatefulSet{}
+	if err := o.SeedClient.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDMain}, statefulSet); err != nil {
+		return err
+	}
+	return health.CheckStatefulSet(statefulSet)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// flowControlGroupVersion is the group version of the API priority and fairness (APF) resources.
+var flowControlGroupVersion = schema.GroupVersion{Group: "flowcontrol.apiserver.k8s.io", Version: "v1alpha1"}
+
+// mandatoryFlowControlObjects are the names of the FlowSchemas and PriorityLevelConfigurations which the API server
+// always maintains if API priority and fairness is enabled.
+var mandatoryFlowControlObjects = map[string][]string{
+	"FlowSchema":                 {"exempt", "catch-all"},
+	"PriorityLevelConfiguration": {"exempt", "catch-all"},
+}
+
+// AssertAPFConfigured waits until the API server of the shoot serves the mandatory FlowSchemas and
+// PriorityLevelConfigurations of API priority and fairness. It returns an error listing the missing objects if they
+// are not present within the given timeout.
+func (o *GardenerTestOperation) AssertAPFConfigured(ctx context.Context, timeout time.Duration) error {
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		var missing []string
+		for _, kind := range []string{"FlowSchema", "PriorityLevelConfiguration"} {
+			for _, name := range mandatoryFlowControlObjects[kind] {
+				obj := &unstructured.Unstructured{}
+				obj.SetGroupVersionKind(flowControlGroupVersion.WithKind(kind))
+
+				if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Name: name}, obj); err != nil {
+					if !apierrors.IsNotFound(err) {
+						return retry.MinorError(fmt.Errorf("could not get %s %s: %v", kind, name, err))
+					}
+					missing = append(missing, fmt.Sprintf("%s %s", kind, name))
+				}
+			}
+		}
+
+		if len(missing) > 0 {
+			o.Logger.Infof("Waiting for API priority and fairness objects %s", strings.Join(missing, ", "))
+			return retry.MinorError(fmt.Errorf("API priority and fairness objects %s are missing", strings.Join(missing, ", ")))
+		}
+
+		o.Logger.Infof("API priority and fairness is configured in the shoot")
+		return retry.Ok()
+	})
+}
@@ -16,16 +16,51 @@ package framework_test
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
+	mockcorev1 "github.com/gardener/gardener/pkg/mock/client-go/core/v1"
+	mockclientset "github.com/gardener/gardener/pkg/mock/client-go/kubernetes"
+	mockrest "github.com/gardener/gardener/pkg/mock/client-go/rest"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
 	. "github.com/gardener/gardener/test/integration/framework"
 )
 
@@ -211,4 +246,1877 @@ var _ = Describe("Framework tests", func() {
 			Expect(*unsupportedRegion).To(Equal(azureRegionEastEurope))
 		})
 	})
+
+	Context("Shoot Assertions - AssertAddonVersions", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "coredns", Namespace: metav1.NamespaceSystem},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "coredns", Image: "coredns/coredns:1.6.3"}}},
+						},
+					},
+				},
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "kube-proxy", Namespace: metav1.NamespaceSystem},
+					Spec: appsv1.DaemonSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "kube-proxy", Image: "k8s.gcr.io:443/hyperkube:v1.15.4"}}},
+						},
+					},
+				},
+			)).AnyTimes()
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if all addons have the expected versions", func() {
+			err := operation.AssertAddonVersions(context.TODO(), map[string]string{"coredns": "1.6.3", "kube-proxy": "v1.15.4"}, time.Second)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if an addon has an unexpected version", func() {
+			err := operation.AssertAddonVersions(context.TODO(), map[string]string{"coredns": "1.6.2", "kube-proxy": "v1.15.4"}, 10*time.Millisecond)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("coredns has version(s) [1.6.3] (expected 1.6.2)"))
+		})
+
+		It("should fail if an addon is not deployed", func() {
+			err := operation.AssertAddonVersions(context.TODO(), map[string]string{"metrics-server": "v0.3.3"}, 10*time.Millisecond)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("metrics-server is not deployed"))
+		})
+	})
+
+	Context("Hibernation Operations", func() {
+		var (
+			ctrl         *gomock.Controller
+			gardenClient *mockkubernetes.MockInterface
+			shootClient  *mockkubernetes.MockInterface
+			operation    *GardenerTestOperation
+			shoot        *gardenv1beta1.Shoot
+
+			readyNode = &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			gardenClient = mockkubernetes.NewMockInterface(ctrl)
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			shoot = &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"}}
+			operation = &GardenerTestOperation{
+				Logger:       logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				GardenClient: gardenClient,
+				ShootClient:  shootClient,
+				Shoot:        shoot,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should hibernate the shoot once its nodes are removed", func() {
+			hibernated := true
+			shoot.Status.IsHibernated = &hibernated
+			garden := fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot.DeepCopy())
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient()).AnyTimes()
+
+			Expect(operation.HibernateShootAndWait(context.TODO(), time.Second)).To(Succeed())
+
+			updatedShoot := &gardenv1beta1.Shoot{}
+			Expect(garden.Get(context.TODO(), client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Name}, updatedShoot)).To(Succeed())
+			Expect(*updatedShoot.Spec.Hibernation.Enabled).To(BeTrue())
+		})
+
+		It("should fail to hibernate the shoot if its nodes are not removed in time", func() {
+			hibernated := true
+			shoot.Status.IsHibernated = &hibernated
+			gardenClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot.DeepCopy())).AnyTimes()
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(readyNode.DeepCopy())).AnyTimes()
+
+			Expect(operation.HibernateShootAndWait(context.TODO(), 10*time.Millisecond)).To(MatchError(ContainSubstring("still has 1 node(s)")))
+		})
+
+		It("should wake up the shoot once its nodes are healthy", func() {
+			hibernated := false
+			shoot.Status.IsHibernated = &hibernated
+			garden := fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot.DeepCopy())
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(readyNode.DeepCopy())).AnyTimes()
+
+			Expect(operation.WakeUpShootAndWait(context.TODO(), time.Second)).To(Succeed())
+
+			updatedShoot := &gardenv1beta1.Shoot{}
+			Expect(garden.Get(context.TODO(), client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Name}, updatedShoot)).To(Succeed())
+			Expect(*updatedShoot.Spec.Hibernation.Enabled).To(BeFalse())
+		})
+
+		It("should fail to wake up the shoot if it is still hibernated", func() {
+			hibernated := true
+			shoot.Status.IsHibernated = &hibernated
+			gardenClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot.DeepCopy())).AnyTimes()
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(readyNode.DeepCopy())).AnyTimes()
+
+			Expect(operation.WakeUpShootAndWait(context.TODO(), 10*time.Millisecond)).To(MatchError(ContainSubstring("is still hibernated")))
+		})
+	})
+
+	Context("Log Operations - StreamGardenComponentLogs", func() {
+		var (
+			ctrl         *gomock.Controller
+			gardenClient *mockkubernetes.MockInterface
+			clientset    *mockclientset.MockInterface
+			pods         *mockcorev1.MockPodInterface
+			httpClient   *mockrest.MockHTTPClient
+			operation    *GardenerTestOperation
+
+			schedulerPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gardener-scheduler-0",
+					Namespace: "garden",
+					Labels:    map[string]string{"app": "gardener", "role": "scheduler"},
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			}
+			isSchedulingDecision = func(line string) bool {
+				return strings.Contains(line, "scheduled to seed")
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			gardenClient = mockkubernetes.NewMockInterface(ctrl)
+			clientset = mockclientset.NewMockInterface(ctrl)
+			pods = mockcorev1.NewMockPodInterface(ctrl)
+			httpClient = mockrest.NewMockHTTPClient(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:       logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				GardenClient: gardenClient,
+			}
+
+			gardenClient.EXPECT().Client().Return(fake.NewFakeClient(schedulerPod.DeepCopy())).AnyTimes()
+			gardenClient.EXPECT().Kubernetes().Return(clientset)
+			clientset.EXPECT().CoreV1().Return(&podLogsCoreV1{pods: pods})
+			pods.EXPECT().GetLogs(schedulerPod.Name, &corev1.PodLogOptions{Follow: true}).Return(rest.NewRequest(httpClient, http.MethodGet, &url.URL{}, "", rest.ContentConfig{}, rest.Serializers{}, nil, nil, 0))
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		respondWith := func(body io.ReadCloser) {
+			httpClient.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusOK, Body: body}, nil)
+		}
+
+		It("should return the first matching log line", func() {
+			respondWith(ioutil.NopCloser(strings.NewReader("starting scheduler\nshoot garden-dev/foo scheduled to seed bar\nshoot garden-dev/baz scheduled to seed bar\n")))
+
+			line, err := operation.StreamGardenComponentLogs(context.TODO(), "scheduler", isSchedulingDecision)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(line).To(Equal("shoot garden-dev/foo scheduled to seed bar"))
+		})
+
+		It("should fail if the log stream ends without a matching line", func() {
+			respondWith(ioutil.NopCloser(strings.NewReader("starting scheduler\n")))
+
+			_, err := operation.StreamGardenComponentLogs(context.TODO(), "scheduler", isSchedulingDecision)
+			Expect(err).To(MatchError(ContainSubstring("log stream ended without a matching line")))
+		})
+
+		It("should fail if the context expires before a matching line appears", func() {
+			reader, writer := io.Pipe()
+			defer writer.Close()
+			respondWith(reader)
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err := operation.StreamGardenComponentLogs(ctx, "scheduler", isSchedulingDecision)
+			Expect(err).To(MatchError(ContainSubstring("no matching log line found")))
+		})
+	})
+
+	Context("Shoot Assertions - AssertLoadBalancerReachable", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+			backend     *httptest.Server
+
+			namespace = "default"
+			service   = "lb-test"
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+			backend = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+		})
+
+		AfterEach(func() {
+			backend.Close()
+			ctrl.Finish()
+		})
+
+		It("should reach the backend through the load balancer and clean up afterwards", func() {
+			// the fake client does not provision load balancers, hence the service already has an external address
+			loadBalancer := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: service},
+				Status: corev1.ServiceStatus{
+					LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{{Hostname: strings.TrimPrefix(backend.URL, "http://")}},
+					},
+				},
+			}
+			shoot := fake.NewFakeClient(loadBalancer)
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+
+			Expect(operation.AssertLoadBalancerReachable(context.TODO(), namespace, service, time.Second)).To(Succeed())
+
+			err := shoot.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: service}, &appsv1.Deployment{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should fail if the load balancer does not get an external address in time", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient()).AnyTimes()
+
+			err := operation.AssertLoadBalancerReachable(context.TODO(), namespace, service, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("external load balancer has not been created")))
+		})
+	})
+
+	Context("Network Operations - AssertShootIsolationOnSeed", func() {
+		var (
+			ctrl       *gomock.Controller
+			seedClient *mockkubernetes.MockInterface
+			executor   *fakePodExecutor
+			operation  *GardenerTestOperation
+
+			namespace      = "shoot--dev--shoot"
+			otherNamespace = "shoot--dev--other"
+			prometheus     = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "prometheus-0", Labels: map[string]string{"app": "prometheus", "role": "monitoring"}},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			}
+			otherAPIServer = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: otherNamespace, Name: "kube-apiserver"},
+				Spec: corev1.ServiceSpec{
+					ClusterIP: "10.0.0.1",
+					Ports:     []corev1.ServicePort{{Port: 443, Protocol: corev1.ProtocolTCP}},
+				},
+			}
+			otherETCD = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: otherNamespace, Name: "etcd-main-client"},
+				Spec: corev1.ServiceSpec{
+					ClusterIP: "10.0.0.2",
+					Ports:     []corev1.ServicePort{{Port: 2379}},
+				},
+			}
+			otherHeadless = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: otherNamespace, Name: "headless"},
+				Spec: corev1.ServiceSpec{
+					ClusterIP: corev1.ClusterIPNone,
+					Ports:     []corev1.ServicePort{{Port: 80}},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			seedClient = mockkubernetes.NewMockInterface(ctrl)
+			executor = &fakePodExecutor{reachable: map[string]bool{}}
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				SeedClient:  seedClient,
+				Project:     &gardenv1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+				Shoot:       &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot"}, Status: gardenv1beta1.ShootStatus{TechnicalID: namespace}},
+				PodExecutor: executor,
+			}
+
+			seedClient.EXPECT().Client().Return(fake.NewFakeClient(prometheus.DeepCopy(), otherAPIServer.DeepCopy(), otherETCD.DeepCopy(), otherHeadless.DeepCopy())).AnyTimes()
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if no service of the other control plane is reachable", func() {
+			Expect(operation.AssertShootIsolationOnSeed(context.TODO(), otherNamespace)).To(Succeed())
+			Expect(executor.commands).To(ConsistOf(
+				"prometheus-0/prometheus: nc -z -w 3 10.0.0.1 443 && echo reachable || echo not-reachable",
+				"prometheus-0/prometheus: nc -z -w 3 10.0.0.2 2379 && echo reachable || echo not-reachable",
+			))
+		})
+
+		It("should fail and list the services of the other control plane that are reachable", func() {
+			executor.reachable["10.0.0.2"] = true
+
+			err := operation.AssertShootIsolationOnSeed(context.TODO(), otherNamespace)
+			Expect(err).To(MatchError("control plane of shoot shoot (pod shoot--dev--shoot/prometheus-0) can reach the following services of the control plane in namespace shoot--dev--other: shoot--dev--other/etcd-main-client at 10.0.0.2:2379"))
+		})
+
+		It("should fail if the probe cannot be executed", func() {
+			executor.err = fmt.Errorf("container not found")
+
+			err := operation.AssertShootIsolationOnSeed(context.TODO(), otherNamespace)
+			Expect(err).To(MatchError(ContainSubstring("container not found")))
+		})
+
+		It("should fail if the other namespace is the namespace of the shoot itself", func() {
+			err := operation.AssertShootIsolationOnSeed(context.TODO(), namespace)
+			Expect(err).To(MatchError(ContainSubstring("is the seed namespace of shoot shoot itself")))
+			Expect(executor.commands).To(BeEmpty())
+		})
+	})
+
+	Context("Shoot Assertions - AssertLoadBalancerHealthy", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+
+			namespace    = "default"
+			service      = "lb-test"
+			loadBalancer = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: service},
+				Status: corev1.ServiceStatus{
+					LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}},
+					},
+				},
+			}
+		)
+
+		newEndpoints := func(ready, notReady int) *corev1.Endpoints {
+			subset := corev1.EndpointSubset{}
+			for i := 0; i < ready; i++ {
+				subset.Addresses = append(subset.Addresses, corev1.EndpointAddress{IP: fmt.Sprintf("10.0.0.%d", i)})
+			}
+			for i := 0; i < notReady; i++ {
+				subset.NotReadyAddresses = append(subset.NotReadyAddresses, corev1.EndpointAddress{IP: fmt.Sprintf("10.0.1.%d", i)})
+			}
+			return &corev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: service},
+				Subsets:    []corev1.EndpointSubset{subset},
+			}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if all backends are healthy", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(loadBalancer.DeepCopy(), newEndpoints(2, 0))).AnyTimes()
+
+			Expect(operation.AssertLoadBalancerHealthy(context.TODO(), namespace, service, time.Second)).To(Succeed())
+		})
+
+		It("should fail if a backend is unhealthy", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(loadBalancer.DeepCopy(), newEndpoints(1, 1))).AnyTimes()
+
+			err := operation.AssertLoadBalancerHealthy(context.TODO(), namespace, service, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("load balancer default/lb-test has 1 healthy and 1 unhealthy backends")))
+		})
+
+		It("should fail if the load balancer has no backends", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(loadBalancer.DeepCopy(), newEndpoints(0, 0))).AnyTimes()
+
+			err := operation.AssertLoadBalancerHealthy(context.TODO(), namespace, service, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("has 0 healthy and 0 unhealthy backends")))
+		})
+
+		It("should use the configured health checker", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(loadBalancer.DeepCopy())).AnyTimes()
+			checker := &fakeLoadBalancerHealthChecker{healthy: 3}
+			operation.LoadBalancerHealthChecker = checker
+
+			Expect(operation.AssertLoadBalancerHealthy(context.TODO(), namespace, service, time.Second)).To(Succeed())
+			Expect(checker.checked).To(ConsistOf(service))
+
+			checker.healthy, checker.unhealthy = 2, 1
+			err := operation.AssertLoadBalancerHealthy(context.TODO(), namespace, service, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("has 2 healthy and 1 unhealthy backends")))
+		})
+	})
+
+	Context("Etcd Operations", func() {
+		var (
+			ctrl       *gomock.Controller
+			seedClient *mockkubernetes.MockInterface
+			operation  *GardenerTestOperation
+
+			namespace = "shoot--dev--foo"
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			seedClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:     logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				SeedClient: seedClient,
+				Seed: &gardenv1beta1.Seed{
+					ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+					Spec:       gardenv1beta1.SeedSpec{Backup: &gardenv1beta1.BackupProfile{}},
+				},
+				Shoot: &gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"},
+					Status:     gardenv1beta1.ShootStatus{TechnicalID: namespace, UID: "1234"},
+				},
+				Project: &gardenv1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		Context("#AssertEtcdBackupExists", func() {
+			It("should succeed if the backup entry has been reconciled successfully", func() {
+				backupEntry := &extensionsv1alpha1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{Name: namespace + "--1234"},
+					Status: extensionsv1alpha1.BackupEntryStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							LastOperation: &gardencorev1alpha1.LastOperation{State: gardencorev1alpha1.LastOperationStateSucceeded},
+						},
+					},
+				}
+				seedClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(kubernetes.SeedScheme, backupEntry)).AnyTimes()
+
+				Expect(operation.AssertEtcdBackupExists(context.TODO(), time.Second)).To(Succeed())
+			})
+
+			It("should fail if the backup entry does not exist", func() {
+				seedClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(kubernetes.SeedScheme)).AnyTimes()
+
+				err := operation.AssertEtcdBackupExists(context.TODO(), 10*time.Millisecond)
+				Expect(err).To(MatchError(ContainSubstring("backup entry " + namespace + "--1234 does not exist in seed seed")))
+			})
+
+			It("should fail if the seed has no backup configured", func() {
+				operation.Seed.Spec.Backup = nil
+
+				err := operation.AssertEtcdBackupExists(context.TODO(), time.Second)
+				Expect(err).To(MatchError(ContainSubstring("seed seed has no backup configured")))
+			})
+		})
+
+		Context("#TriggerRestoreAndWait", func() {
+			var (
+				replicas = int32(1)
+
+				etcdPod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "etcd-main-0", Namespace: namespace, UID: "old"},
+				}
+				etcdVolumeClaim = &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "main-etcd-etcd-main-0", Namespace: namespace},
+				}
+				etcdStatefulSet = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace},
+					Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+					Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+				}
+			)
+
+			It("should delete the etcd volume and pod and wait for the recreated etcd", func() {
+				seed := &recreatingPodClient{Client: fake.NewFakeClientWithScheme(kubernetes.SeedScheme, etcdPod.DeepCopy(), etcdVolumeClaim.DeepCopy(), etcdStatefulSet.DeepCopy())}
+				seedClient.EXPECT().Client().Return(seed).AnyTimes()
+
+				Expect(operation.TriggerRestoreAndWait(context.TODO(), time.Second)).To(Succeed())
+
+				err := seed.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: etcdVolumeClaim.Name}, &corev1.PersistentVolumeClaim{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should fail if the etcd pod is not recreated in time", func() {
+				seedClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(kubernetes.SeedScheme, etcdPod.DeepCopy(), etcdVolumeClaim.DeepCopy(), etcdStatefulSet.DeepCopy())).AnyTimes()
+
+				err := operation.TriggerRestoreAndWait(context.TODO(), 10*time.Millisecond)
+				Expect(err).To(MatchError(ContainSubstring("etcd pod " + namespace + "/etcd-main-0 is not available")))
+			})
+
+			It("should fail if the etcd pod does not exist", func() {
+				seedClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(kubernetes.SeedScheme)).AnyTimes()
+
+				err := operation.TriggerRestoreAndWait(context.TODO(), time.Second)
+				Expect(err).To(MatchError(ContainSubstring("could not get etcd pod")))
+			})
+		})
+	})
+
+	Context("Credentials Operations - RotateKubeconfigCredentialsAndWait", func() {
+		var (
+			ctrl         *gomock.Controller
+			gardenClient *mockkubernetes.MockInterface
+			operation    *GardenerTestOperation
+			shoot        *gardenv1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			gardenClient = mockkubernetes.NewMockInterface(ctrl)
+			shoot = &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"}}
+			operation = &GardenerTestOperation{
+				Logger:       logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				GardenClient: gardenClient,
+				Shoot:        shoot,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed once the rotation has been picked up and the shoot has been reconciled", func() {
+			garden := &rotatingShootClient{Client: fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot.DeepCopy()), state: gardencorev1alpha1.LastOperationStateSucceeded}
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+
+			Expect(operation.RotateKubeconfigCredentialsAndWait(context.TODO(), time.Second)).To(Succeed())
+
+			Expect(garden.rotations).To(Equal(1))
+			Expect(operation.Shoot.Annotations).NotTo(HaveKey(common.ShootOperation))
+		})
+
+		It("should fail if the rotation is not picked up in time", func() {
+			gardenClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot.DeepCopy())).AnyTimes()
+
+			Expect(operation.RotateKubeconfigCredentialsAndWait(context.TODO(), 10*time.Millisecond)).To(MatchError(ContainSubstring("has not been picked up yet")))
+		})
+
+		It("should fail with the status of the shoot if the reconciliation after the rotation fails", func() {
+			garden := &rotatingShootClient{Client: fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot.DeepCopy()), state: gardencorev1alpha1.LastOperationStateFailed}
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+
+			Expect(operation.RotateKubeconfigCredentialsAndWait(context.TODO(), 10*time.Millisecond)).To(MatchError(ContainSubstring("last operation: Reconcile Failed")))
+		})
+	})
+
+	Context("Seed Operations - SimulateSeedUnavailableAndAssertRecovery", func() {
+		var (
+			ctrl         *gomock.Controller
+			gardenClient *mockkubernetes.MockInterface
+			operation    *GardenerTestOperation
+			seed         *gardenv1beta1.Seed
+			shoot        *gardenv1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			gardenClient = mockkubernetes.NewMockInterface(ctrl)
+			seed = &gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+				Status: gardenv1beta1.SeedStatus{
+					Conditions: []gardencorev1alpha1.Condition{{Type: gardenv1beta1.SeedAvailable, Status: gardencorev1alpha1.ConditionTrue}},
+				},
+			}
+			shoot = &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"}}
+			operation = &GardenerTestOperation{
+				Logger:       logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				GardenClient: gardenClient,
+				Seed:         seed,
+				Shoot:        shoot,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		setControlPlaneCondition := func(status gardencorev1alpha1.ConditionStatus, lastUpdateTime time.Time) {
+			shoot.Status.Conditions = []gardencorev1alpha1.Condition{{
+				Type:           gardenv1beta1.ShootControlPlaneHealthy,
+				Status:         status,
+				LastUpdateTime: metav1.NewTime(lastUpdateTime),
+				Reason:         "ControlPlaneUnhealthy",
+				Message:        "kube-apiserver is not ready",
+			}}
+		}
+
+		expectSeedAvailable := func(garden client.Client) {
+			updatedSeed := &gardenv1beta1.Seed{}
+			Expect(garden.Get(context.TODO(), client.ObjectKey{Name: seed.Name}, updatedSeed)).To(Succeed())
+			Expect(updatedSeed.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(gardenv1beta1.SeedAvailable),
+				"Status": Equal(gardencorev1alpha1.ConditionTrue),
+				"Reason": Equal("SeedOutageRecovered"),
+			})))
+		}
+
+		It("should succeed once the control plane of the shoot recovered", func() {
+			setControlPlaneCondition(gardencorev1alpha1.ConditionTrue, time.Now().Add(time.Hour))
+			garden := fake.NewFakeClientWithScheme(kubernetes.GardenScheme, seed.DeepCopy(), shoot.DeepCopy())
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+
+			Expect(operation.SimulateSeedUnavailableAndAssertRecovery(context.TODO(), time.Second)).To(Succeed())
+
+			expectSeedAvailable(garden)
+		})
+
+		It("should fail with the condition of the shoot if its control plane does not recover", func() {
+			setControlPlaneCondition(gardencorev1alpha1.ConditionFalse, time.Now().Add(time.Hour))
+			garden := fake.NewFakeClientWithScheme(kubernetes.GardenScheme, seed.DeepCopy(), shoot.DeepCopy())
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+
+			err := operation.SimulateSeedUnavailableAndAssertRecovery(context.TODO(), 10*time.Millisecond)
+
+			Expect(err).To(MatchError(ContainSubstring("has not recovered")))
+			Expect(err).To(MatchError(ContainSubstring("condition ControlPlaneHealthy is False")))
+			Expect(err).To(MatchError(ContainSubstring("kube-apiserver is not ready")))
+			expectSeedAvailable(garden)
+		})
+
+		It("should fail and restore the seed if the shoot is not checked during the outage", func() {
+			setControlPlaneCondition(gardencorev1alpha1.ConditionTrue, time.Now().Add(-time.Hour))
+			garden := fake.NewFakeClientWithScheme(kubernetes.GardenScheme, seed.DeepCopy(), shoot.DeepCopy())
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+
+			Expect(operation.SimulateSeedUnavailableAndAssertRecovery(context.TODO(), 10*time.Millisecond)).To(MatchError(ContainSubstring("has not been checked during the outage")))
+
+			expectSeedAvailable(garden)
+		})
+	})
+
+	Context("Seed Operations - AssertControlPlaneSpread", func() {
+		var (
+			ctrl       *gomock.Controller
+			seedClient *mockkubernetes.MockInterface
+			operation  *GardenerTestOperation
+
+			namespace = "shoot--dev--foo"
+		)
+
+		newPod := func(name, owner, nodeName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       namespace,
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: owner}}, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+				},
+				Spec: corev1.PodSpec{NodeName: nodeName},
+			}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			seedClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:     logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				SeedClient: seedClient,
+				Seed:       &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}},
+				Shoot: &gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"},
+					Status:     gardenv1beta1.ShootStatus{TechnicalID: namespace},
+				},
+				Project: &gardenv1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the replicas of every component run on distinct nodes", func() {
+			seedClient.EXPECT().Client().Return(fake.NewFakeClient(
+				newPod("kube-apiserver-1", "kube-apiserver-abc", "node-1"),
+				newPod("kube-apiserver-2", "kube-apiserver-abc", "node-2"),
+				newPod("kube-apiserver-3", "kube-apiserver-abc", ""),
+				newPod("kube-controller-manager-1", "kube-controller-manager-abc", "node-1"),
+			)).AnyTimes()
+
+			Expect(operation.AssertControlPlaneSpread(context.TODO(), time.Second)).To(Succeed())
+		})
+
+		It("should fail if replicas of a component are co-located on a node", func() {
+			seedClient.EXPECT().Client().Return(fake.NewFakeClient(
+				newPod("kube-apiserver-1", "kube-apiserver-abc", "node-1"),
+				newPod("kube-apiserver-2", "kube-apiserver-abc", "node-1"),
+				newPod("kube-apiserver-3", "kube-apiserver-abc", "node-2"),
+			)).AnyTimes()
+
+			err := operation.AssertControlPlaneSpread(context.TODO(), 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("pods [kube-apiserver-1, kube-apiserver-2] of ReplicaSet kube-apiserver-abc run on node node-1")))
+		})
+	})
+
+	Context("Node Operations - AssertWorkloadSurvivesNodeDrain", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+
+			podLabels  = map[string]string{"app": "foo"}
+			deployment *appsv1.Deployment
+			pod        *corev1.Pod
+			otherPod   *corev1.Pod
+			daemonPod  *corev1.Pod
+			node       *corev1.Node
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: podLabels}},
+				Status: appsv1.DeploymentStatus{
+					AvailableReplicas: 1,
+					Conditions:        []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}},
+				},
+			}
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo-1", Namespace: "default", Labels: podLabels},
+				Spec:       corev1.PodSpec{NodeName: "node-1"},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			}
+			otherPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "kube-system"},
+				Spec:       corev1.PodSpec{NodeName: "node-1"},
+			}
+			daemonPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "node-exporter",
+					Namespace:       "kube-system",
+					OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "node-exporter"}},
+				},
+				Spec: corev1.PodSpec{NodeName: "node-1"},
+			}
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the workload is rescheduled to another node and stays available", func() {
+			shoot := &reschedulingPodClient{Client: fake.NewFakeClient(deployment, pod, otherPod, daemonPod, node), nodeName: "node-2"}
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+
+			Expect(operation.AssertWorkloadSurvivesNodeDrain(context.TODO(), deployment.Namespace, deployment.Name, time.Second)).To(Succeed())
+
+			err := shoot.Get(context.TODO(), client.ObjectKey{Namespace: otherPod.Namespace, Name: otherPod.Name}, &corev1.Pod{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(shoot.Get(context.TODO(), client.ObjectKey{Namespace: daemonPod.Namespace, Name: daemonPod.Name}, &corev1.Pod{})).To(Succeed())
+
+			drainedNode := &corev1.Node{}
+			Expect(shoot.Get(context.TODO(), client.ObjectKey{Name: node.Name}, drainedNode)).To(Succeed())
+			Expect(drainedNode.Spec.Unschedulable).To(BeFalse())
+		})
+
+		It("should fail if the workload becomes unavailable during the drain", func() {
+			deployment.Status.AvailableReplicas = 0
+			shootClient.EXPECT().Client().Return(&reschedulingPodClient{Client: fake.NewFakeClient(deployment, pod, node), nodeName: "node-2"}).AnyTimes()
+
+			Expect(operation.AssertWorkloadSurvivesNodeDrain(context.TODO(), deployment.Namespace, deployment.Name, time.Second)).To(MatchError(ContainSubstring("became unavailable while draining node node-1")))
+		})
+
+		It("should fail if the workload is not rescheduled in time", func() {
+			shootClient.EXPECT().Client().Return(&reschedulingPodClient{Client: fake.NewFakeClient(deployment, pod, node), nodeName: "node-1"}).AnyTimes()
+
+			Expect(operation.AssertWorkloadSurvivesNodeDrain(context.TODO(), deployment.Namespace, deployment.Name, 10*time.Millisecond)).To(MatchError(ContainSubstring("is still running on the drained node node-1")))
+		})
+
+		It("should fail if the workload has no running pod", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(deployment, node)).AnyTimes()
+
+			Expect(operation.AssertWorkloadSurvivesNodeDrain(context.TODO(), deployment.Namespace, deployment.Name, time.Second)).To(MatchError(ContainSubstring("could not find a running pod")))
+		})
+	})
+
+	Context("Node Operations - AssertPDBRespectedDuringDrain", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+
+			podLabels = map[string]string{"app": "foo"}
+			pdb       *policyv1beta1.PodDisruptionBudget
+			nodes     []runtime.Object
+		)
+
+		newReadyPod := func(name, nodeName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: podLabels},
+				Spec:       corev1.PodSpec{NodeName: nodeName},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+
+			minAvailable := intstr.FromInt(1)
+			pdb = &policyv1beta1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec: policyv1beta1.PodDisruptionBudgetSpec{
+					MinAvailable: &minAvailable,
+					Selector:     &metav1.LabelSelector{MatchLabels: podLabels},
+				},
+			}
+			nodes = []runtime.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if enough pods stay ready during the drain", func() {
+			objects := append(nodes, pdb, newReadyPod("foo-1", "node-1"), newReadyPod("foo-2", "node-2"))
+			shoot := &reschedulingPodClient{Client: fake.NewFakeClient(objects...), nodeName: "node-2"}
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+
+			Expect(operation.AssertPDBRespectedDuringDrain(context.TODO(), pdb.Namespace, pdb.Name, time.Second)).To(Succeed())
+
+			drainedNode := &corev1.Node{}
+			Expect(shoot.Get(context.TODO(), client.ObjectKey{Name: "node-1"}, drainedNode)).To(Succeed())
+			Expect(drainedNode.Spec.Unschedulable).To(BeFalse())
+		})
+
+		It("should resolve a percentage against the number of protected pods", func() {
+			minAvailable := intstr.FromString("50%")
+			pdb.Spec.MinAvailable = &minAvailable
+			objects := append(nodes, pdb, newReadyPod("foo-1", "node-1"), newReadyPod("foo-2", "node-2"))
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(objects...)).AnyTimes()
+
+			Expect(operation.AssertPDBRespectedDuringDrain(context.TODO(), pdb.Namespace, pdb.Name, time.Second)).To(Succeed())
+		})
+
+		It("should fail if the drain violates the pod disruption budget", func() {
+			objects := append(nodes, pdb, newReadyPod("foo-1", "node-1"), newReadyPod("foo-2", "node-1"))
+			shoot := fake.NewFakeClient(objects...)
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+
+			Expect(operation.AssertPDBRespectedDuringDrain(context.TODO(), pdb.Namespace, pdb.Name, time.Second)).To(MatchError(ContainSubstring("was violated while draining node node-1: only 0 ready pod(s), but minAvailable is 1")))
+
+			drainedNode := &corev1.Node{}
+			Expect(shoot.Get(context.TODO(), client.ObjectKey{Name: "node-1"}, drainedNode)).To(Succeed())
+			Expect(drainedNode.Spec.Unschedulable).To(BeFalse())
+		})
+
+		It("should fail if the pod disruption budget does not specify minAvailable", func() {
+			pdb.Spec.MinAvailable = nil
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(append(nodes, pdb)...)).AnyTimes()
+
+			Expect(operation.AssertPDBRespectedDuringDrain(context.TODO(), pdb.Namespace, pdb.Name, time.Second)).To(MatchError(ContainSubstring("does not specify minAvailable")))
+		})
+	})
+
+	Context("Node Operations - AssertPodsSpreadAcrossZones", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+
+			selector = labels.SelectorFromSet(labels.Set{"app": "foo"})
+		)
+
+		newNode := func(name, zone string) *corev1.Node {
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{corev1.LabelZoneFailureDomain: zone}}}
+		}
+		newPod := func(name, nodeName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "foo"}},
+				Spec:       corev1.PodSpec{NodeName: nodeName},
+			}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the pods run in enough zones", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(
+				newNode("node-1", "zone-a"),
+				newNode("node-2", "zone-b"),
+				newPod("foo-1", "node-1"),
+				newPod("foo-2", "node-2"),
+				newPod("foo-3", ""),
+			)).AnyTimes()
+
+			Expect(operation.AssertPodsSpreadAcrossZones(context.TODO(), "default", selector, 2)).To(Succeed())
+		})
+
+		It("should fail if the pods are concentrated in a single zone", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(
+				newNode("node-1", "zone-a"),
+				newNode("node-2", "zone-a"),
+				newNode("node-3", "zone-b"),
+				newPod("foo-1", "node-1"),
+				newPod("foo-2", "node-2"),
+			)).AnyTimes()
+
+			Expect(operation.AssertPodsSpreadAcrossZones(context.TODO(), "default", selector, 2)).To(MatchError(ContainSubstring("run in 1 zone(s) [zone-a] but at least 2 zone(s) are required")))
+		})
+	})
+
+	Context("Node Operations - AssertNodeConditionEventually", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+
+			conditionType corev1.NodeConditionType = "KernelDeadlock"
+		)
+
+		newNode := func(conditions ...corev1.NodeCondition) *corev1.Node {
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}, Status: corev1.NodeStatus{Conditions: conditions}}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the condition has the expected status", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(newNode(
+				corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				corev1.NodeCondition{Type: conditionType, Status: corev1.ConditionTrue},
+			))).AnyTimes()
+
+			Expect(operation.AssertNodeConditionEventually(context.TODO(), "node-1", conditionType, corev1.ConditionTrue, time.Second)).To(Succeed())
+		})
+
+		It("should fail if the condition does not reach the expected status within the timeout", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(newNode(
+				corev1.NodeCondition{Type: conditionType, Status: corev1.ConditionFalse},
+			))).AnyTimes()
+
+			err := operation.AssertNodeConditionEventually(context.TODO(), "node-1", conditionType, corev1.ConditionTrue, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("condition KernelDeadlock of node node-1 has status False instead of True")))
+		})
+
+		It("should fail if the node does not report the condition", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(newNode(
+				corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			))).AnyTimes()
+
+			err := operation.AssertNodeConditionEventually(context.TODO(), "node-1", conditionType, corev1.ConditionTrue, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("node node-1 does not report condition KernelDeadlock")))
+		})
+
+		It("should fail if the node does not exist", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient()).AnyTimes()
+
+			err := operation.AssertNodeConditionEventually(context.TODO(), "node-1", conditionType, corev1.ConditionTrue, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("could not get node node-1")))
+		})
+	})
+
+	Context("Node Operations - AssertMachineImageUpdateRollsNodes", func() {
+		var (
+			ctrl         *gomock.Controller
+			gardenClient *mockkubernetes.MockInterface
+			shootClient  *mockkubernetes.MockInterface
+			garden       client.Client
+			operation    *GardenerTestOperation
+
+			oldImage = &gardenv1beta1.ShootMachineImage{Name: "coreos", Version: "2023.4.0"}
+			newImage = &gardenv1beta1.ShootMachineImage{Name: "coreos", Version: "2135.6.0"}
+		)
+
+		newNode := func(name string, ready bool) corev1.Node {
+			status := corev1.ConditionFalse
+			if ready {
+				status = corev1.ConditionTrue
+			}
+			return corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}}}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			gardenClient = mockkubernetes.NewMockInterface(ctrl)
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+
+			shoot := &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						AWS: &gardenv1beta1.AWSCloud{
+							MachineImage: oldImage.DeepCopy(),
+							Workers:      []gardenv1beta1.AWSWorker{{Worker: gardenv1beta1.Worker{Name: "worker", MachineImage: oldImage.DeepCopy()}}},
+						},
+					},
+				},
+			}
+			garden = fake.NewFakeClientWithScheme(kubernetes.GardenScheme, shoot)
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+			operation = &GardenerTestOperation{
+				Logger:       logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				GardenClient: gardenClient,
+				ShootClient:  shootClient,
+				Shoot:        shoot,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the nodes are replaced one-by-one", func() {
+			shootClient.EXPECT().Client().Return(&rollingNodeClient{Client: fake.NewFakeClient(), steps: [][]corev1.Node{
+				{newNode("node-a", true), newNode("node-b", true)},
+				{newNode("node-a", false), newNode("node-b", true)},
+				{newNode("node-b", true), newNode("node-c", true)},
+				{newNode("node-b", false), newNode("node-c", true), newNode("node-d", false)},
+				{newNode("node-c", true), newNode("node-d", true)},
+			}}).AnyTimes()
+
+			Expect(operation.AssertMachineImageUpdateRollsNodes(context.TODO(), newImage, 10*time.Second)).To(Succeed())
+
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(garden.Get(context.TODO(), client.ObjectKey{Namespace: "garden-dev", Name: "shoot"}, shoot)).To(Succeed())
+			Expect(shoot.Spec.Cloud.AWS.MachineImage).To(Equal(newImage))
+			Expect(shoot.Spec.Cloud.AWS.Workers[0].MachineImage).To(Equal(newImage))
+		})
+
+		It("should fail if too many nodes are unavailable at the same time", func() {
+			shootClient.EXPECT().Client().Return(&rollingNodeClient{Client: fake.NewFakeClient(), steps: [][]corev1.Node{
+				{newNode("node-a", true), newNode("node-b", true)},
+				{newNode("node-a", false), newNode("node-b", false)},
+			}}).AnyTimes()
+
+			err := operation.AssertMachineImageUpdateRollsNodes(context.TODO(), newImage, 10*time.Second)
+			Expect(err).To(MatchError(ContainSubstring("2 nodes of shoot shoot are unavailable at the same time while rolling the nodes (at most 1 allowed), NotReady nodes: [node-a, node-b]")))
+		})
+
+		It("should fail if the nodes are not replaced within the timeout", func() {
+			shootClient.EXPECT().Client().Return(&rollingNodeClient{Client: fake.NewFakeClient(), steps: [][]corev1.Node{
+				{newNode("node-a", true), newNode("node-b", true)},
+			}}).AnyTimes()
+
+			err := operation.AssertMachineImageUpdateRollsNodes(context.TODO(), newImage, 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("nodes of shoot shoot have not been replaced yet: node-a, node-b")))
+		})
+	})
+
+	Context("Flow Control Operations - AssertAPFConfigured", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			operation   *GardenerTestOperation
+		)
+
+		newFlowControlObject := func(kind, name string) *unstructured.Unstructured {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion("flowcontrol.apiserver.k8s.io/v1alpha1")
+			obj.SetKind(kind)
+			obj.SetName(name)
+			return obj
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if all mandatory objects exist", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(
+				newFlowControlObject("FlowSchema", "exempt"),
+				newFlowControlObject("FlowSchema", "catch-all"),
+				newFlowControlObject("PriorityLevelConfiguration", "exempt"),
+				newFlowControlObject("PriorityLevelConfiguration", "catch-all"),
+			)).AnyTimes()
+
+			Expect(operation.AssertAPFConfigured(context.TODO(), time.Second)).To(Succeed())
+		})
+
+		It("should fail if mandatory objects are missing", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient(
+				newFlowControlObject("FlowSchema", "exempt"),
+				newFlowControlObject("PriorityLevelConfiguration", "exempt"),
+				newFlowControlObject("PriorityLevelConfiguration", "catch-all"),
+			)).AnyTimes()
+
+			err := operation.AssertAPFConfigured(context.TODO(), 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("API priority and fairness objects FlowSchema catch-all are missing")))
+		})
+	})
+
+	Context("DNS Operations - AssertDNSRecordExists", func() {
+		var (
+			resolver  *fakeResolver
+			operation *GardenerTestOperation
+		)
+
+		BeforeEach(func() {
+			resolver = &fakeResolver{
+				hosts:  map[string][]string{"api.foo.example.com": {"10.0.0.1", "10.0.0.2"}},
+				cnames: map[string]string{"api.bar.example.com": "lb.example.com."},
+			}
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				DNSResolver: resolver,
+			}
+		})
+
+		It("should succeed if the host resolves to the expected address", func() {
+			Expect(operation.AssertDNSRecordExists(context.TODO(), "api.foo.example.com", "10.0.0.2", time.Second)).To(Succeed())
+		})
+
+		It("should succeed if the host is an alias of the expected name", func() {
+			Expect(operation.AssertDNSRecordExists(context.TODO(), "api.bar.example.com", "lb.example.com", time.Second)).To(Succeed())
+		})
+
+		It("should fail if the host resolves to another address", func() {
+			Expect(operation.AssertDNSRecordExists(context.TODO(), "api.foo.example.com", "10.0.0.3", 10*time.Millisecond)).To(MatchError(ContainSubstring("DNS record api.foo.example.com resolves to [10.0.0.1 10.0.0.2] instead of 10.0.0.3")))
+		})
+
+		It("should fail if the host cannot be resolved", func() {
+			Expect(operation.AssertDNSRecordExists(context.TODO(), "api.baz.example.com", "lb.example.com", 10*time.Millisecond)).To(MatchError(ContainSubstring("could not resolve canonical name of api.baz.example.com")))
+		})
+	})
+
+	Context("Audit Operations - AssertAuditLogsDelivered", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			shoot       client.Client
+			operation   *GardenerTestOperation
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			shoot = fake.NewFakeClient()
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the audit log entry is delivered to the backend", func() {
+			backend := &fakeAuditLogBackend{client: shoot}
+
+			Expect(operation.AssertAuditLogsDelivered(context.TODO(), backend, time.Second)).To(Succeed())
+			Expect(backend.queried).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Verb":      Equal("create"),
+				"Resource":  Equal("configmaps"),
+				"Namespace": Equal("default"),
+			})))
+
+			configMaps := &corev1.ConfigMapList{}
+			Expect(shoot.List(context.TODO(), configMaps)).To(Succeed())
+			Expect(configMaps.Items).To(BeEmpty())
+		})
+
+		It("should fail if the audit log entry is not delivered in time", func() {
+			backend := &fakeAuditLogBackend{}
+
+			Expect(operation.AssertAuditLogsDelivered(context.TODO(), backend, 10*time.Millisecond)).To(MatchError(ContainSubstring("has not been delivered to the backend")))
+		})
+	})
+
+	Context("Metrics Operations - AssertControlPlaneMetricsAvailable", func() {
+		var (
+			ctrl       *gomock.Controller
+			seedClient *mockkubernetes.MockInterface
+			operation  *GardenerTestOperation
+			prometheus *httptest.Server
+
+			available map[string]bool
+			queried   []string
+		)
+
+		BeforeEach(func() {
+			available = map[string]bool{}
+			queried = nil
+
+			prometheus = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/namespaces/shoot--dev--foo/services/http:prometheus-web:80/proxy/api/v1/query" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				query := r.URL.Query().Get("query")
+				queried = append(queried, query)
+
+				result := "[]"
+				if available[query] {
+					result = fmt.Sprintf(`[{"metric":{"__name__":%q},"value":[1570000000,"1"]}]`, query)
+				}
+				fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":%s}}`, result)
+			}))
+
+			ctrl = gomock.NewController(GinkgoT())
+			seedClient = mockkubernetes.NewMockInterface(ctrl)
+			seedClient.EXPECT().Kubernetes().Return(kubernetesclientset.NewForConfigOrDie(&rest.Config{Host: prometheus.URL})).AnyTimes()
+
+			operation = &GardenerTestOperation{
+				Logger:     logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				SeedClient: seedClient,
+				Project:    &gardenv1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+				Shoot:      &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"}},
+			}
+		})
+
+		AfterEach(func() {
+			prometheus.Close()
+			ctrl.Finish()
+		})
+
+		It("should succeed if all default series are available", func() {
+			for _, series := range DefaultControlPlaneMetrics {
+				available[series] = true
+			}
+
+			Expect(operation.AssertControlPlaneMetricsAvailable(context.TODO(), time.Second)).To(Succeed())
+			Expect(queried).To(ConsistOf(DefaultControlPlaneMetrics))
+		})
+
+		It("should only require the given series", func() {
+			available["up"] = true
+
+			Expect(operation.AssertControlPlaneMetricsAvailable(context.TODO(), time.Second, "up")).To(Succeed())
+			Expect(queried).To(ConsistOf("up"))
+		})
+
+		It("should fail if a series is not available within the timeout", func() {
+			available["up"] = true
+
+			err := operation.AssertControlPlaneMetricsAvailable(context.TODO(), 10*time.Millisecond, "up", "missing_series")
+			Expect(err).To(MatchError(ContainSubstring("control plane metrics missing_series are not available")))
+		})
+	})
+
+	Context("Conformance Operations - RunConformanceSmoke", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			executor    *staticPodExecutor
+			operation   *GardenerTestOperation
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			executor = &staticPodExecutor{}
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+				Shoot:       &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot"}},
+				PodExecutor: executor,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should report all checks as passed", func() {
+			shoot := &startingShootClient{Client: fake.NewFakeClient(), bindVolumeClaims: true}
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+
+			report, err := operation.RunConformanceSmoke(context.TODO(), time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Passed()).To(BeTrue())
+			Expect(report.String()).To(Equal("pod-scheduling: passed; service-dns: passed; pvc-binding: passed"))
+			Expect(executor.commands).To(ConsistOf("conformance-smoke/smoke: nslookup kubernetes.default"))
+
+			pods := &corev1.PodList{}
+			Expect(shoot.List(context.TODO(), pods)).To(Succeed())
+			Expect(pods.Items).To(BeEmpty())
+		})
+
+		It("should aggregate the failed checks", func() {
+			executor.err = fmt.Errorf("nslookup: can't resolve 'kubernetes.default'")
+			shootClient.EXPECT().Client().Return(&startingShootClient{Client: fake.NewFakeClient()}).AnyTimes()
+
+			report, err := operation.RunConformanceSmoke(context.TODO(), 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("conformance smoke test of shoot shoot failed")))
+			Expect(report.Passed()).To(BeFalse())
+			Expect(report.Checks).To(HaveLen(3))
+			Expect(report.Checks[0].Err).NotTo(HaveOccurred())
+			Expect(report.Checks[1].Err).To(MatchError(ContainSubstring("service kubernetes.default cannot be resolved from pod default/conformance-smoke")))
+			Expect(report.Checks[2].Err).To(MatchError(ContainSubstring("persistent volume claim default/conformance-smoke-volume is not bound")))
+		})
+
+		It("should not resolve the service if the pod is not scheduled", func() {
+			shootClient.EXPECT().Client().Return(fake.NewFakeClient()).AnyTimes()
+
+			report, err := operation.RunConformanceSmoke(context.TODO(), 10*time.Millisecond)
+			Expect(err).To(HaveOccurred())
+			Expect(report.Checks[0].Err).To(MatchError(ContainSubstring("pod default/conformance-smoke is not ready")))
+			Expect(report.Checks[1].Err).To(MatchError("no ready pod to resolve the service from"))
+			Expect(executor.commands).To(BeEmpty())
+		})
+	})
+
+	Context("Scheduler Operations - AssertShootScheduledWithin", func() {
+		var (
+			ctrl          *gomock.Controller
+			gardenClient  *mockkubernetes.MockInterface
+			garden        *schedulingShootClient
+			schedulerTest *SchedulerGardenerTest
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			gardenClient = mockkubernetes.NewMockInterface(ctrl)
+			garden = &schedulingShootClient{Client: fake.NewFakeClientWithScheme(kubernetes.GardenScheme), seedName: "seed-1"}
+			gardenClient.EXPECT().Client().Return(garden).AnyTimes()
+
+			seedName := "seed-0"
+			schedulerTest = &SchedulerGardenerTest{
+				ShootGardenerTest: &ShootGardenerTest{
+					GardenClient: gardenClient,
+					Shoot: &gardenv1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "shoot"},
+						Spec:       gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seedName}},
+					},
+					Logger: logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the seed is set after a delay within the duration", func() {
+			garden.scheduleAfter = time.Now().Add(100 * time.Millisecond)
+
+			Expect(schedulerTest.AssertShootScheduledWithin(context.TODO(), 5*time.Second)).To(Succeed())
+
+			shoot := &gardenv1beta1.Shoot{}
+			Expect(garden.Get(context.TODO(), client.ObjectKey{Namespace: "garden-dev", Name: "shoot"}, shoot)).To(Succeed())
+			Expect(shoot.Spec.Cloud.Seed).To(PointTo(Equal("seed-1")))
+			Expect(garden.created.Spec.Cloud.Seed).To(BeNil())
+		})
+
+		It("should fail with the last event if the seed is not set within the duration", func() {
+			garden.scheduleAfter = time.Now().Add(time.Hour)
+			involvedObject := corev1.ObjectReference{Kind: "Shoot", Namespace: "garden-dev", Name: "shoot"}
+			gardenClient.EXPECT().Kubernetes().Return(kubernetesfake.NewSimpleClientset(
+				&corev1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Namespace: "garden-dev", Name: "shoot.1"},
+					InvolvedObject: involvedObject,
+					Reason:         "SchedulingFailed",
+					Message:        "no seed in region",
+					LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Minute)),
+				},
+				&corev1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Namespace: "garden-dev", Name: "shoot.2"},
+					InvolvedObject: involvedObject,
+					Reason:         "SchedulingFailed",
+					Message:        "0/2 seeds are available",
+					LastTimestamp:  metav1.NewTime(time.Now()),
+				},
+			))
+
+			err := schedulerTest.AssertShootScheduledWithin(context.TODO(), 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("shoot shoot was not scheduled within 10ms")))
+			Expect(err).To(MatchError(ContainSubstring("last event: SchedulingFailed: 0/2 seeds are available")))
+		})
+
+		It("should fail stating that no event was recorded", func() {
+			garden.scheduleAfter = time.Now().Add(time.Hour)
+			gardenClient.EXPECT().Kubernetes().Return(kubernetesfake.NewSimpleClientset())
+
+			err := schedulerTest.AssertShootScheduledWithin(context.TODO(), 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("(no event recorded)")))
+		})
+	})
+
+	Context("Storage Operations - AssertCSIVolumeLifecycle", func() {
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			executor    *volumeDataPodExecutor
+			operation   *GardenerTestOperation
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			executor = &volumeDataPodExecutor{files: map[string]string{}}
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+				Shoot:       &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot"}},
+				PodExecutor: executor,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should provision, attach, write and read the volume and clean up", func() {
+			shoot := &startingShootClient{Client: fake.NewFakeClient(), bindVolumeClaims: true}
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+
+			Expect(operation.AssertCSIVolumeLifecycle(context.TODO(), "csi-standard", time.Second)).To(Succeed())
+			Expect(executor.commands).To(Equal([]string{
+				"csi-volume-lifecycle/smoke: echo gardener-csi-volume-lifecycle > /data/csi-volume-lifecycle && sync",
+				"csi-volume-lifecycle/smoke: cat /data/csi-volume-lifecycle",
+			}))
+
+			pods := &corev1.PodList{}
+			Expect(shoot.List(context.TODO(), pods)).To(Succeed())
+			Expect(pods.Items).To(BeEmpty())
+			volumeClaims := &corev1.PersistentVolumeClaimList{}
+			Expect(shoot.List(context.TODO(), volumeClaims)).To(Succeed())
+			Expect(volumeClaims.Items).To(BeEmpty())
+		})
+
+		It("should fail if the volume is not provisioned", func() {
+			shoot := &startingShootClient{Client: fake.NewFakeClient()}
+			shootClient.EXPECT().Client().Return(shoot).AnyTimes()
+
+			err := operation.AssertCSIVolumeLifecycle(context.TODO(), "csi-standard", 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("volume of storage class csi-standard was not provisioned")))
+			Expect(executor.commands).To(BeEmpty())
+
+			volumeClaims := &corev1.PersistentVolumeClaimList{}
+			Expect(shoot.List(context.TODO(), volumeClaims)).To(Succeed())
+			Expect(volumeClaims.Items).To(BeEmpty())
+		})
+
+		It("should fail if the data cannot be written", func() {
+			executor.err = fmt.Errorf("read-only file system")
+			shootClient.EXPECT().Client().Return(&startingShootClient{Client: fake.NewFakeClient(), bindVolumeClaims: true}).AnyTimes()
+
+			err := operation.AssertCSIVolumeLifecycle(context.TODO(), "csi-standard", 10*time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("could not write to volume of storage class csi-standard in pod default/csi-volume-lifecycle")))
+		})
+
+		It("should fail if the read data differs from the written one", func() {
+			executor.lossy = true
+			shootClient.EXPECT().Client().Return(&startingShootClient{Client: fake.NewFakeClient(), bindVolumeClaims: true}).AnyTimes()
+
+			err := operation.AssertCSIVolumeLifecycle(context.TODO(), "csi-standard", time.Second)
+			Expect(err).To(MatchError(`volume of storage class csi-standard returned "" instead of the written data "gardener-csi-volume-lifecycle"`))
+		})
+	})
+
+	Context("OIDC Operations - AssertOIDCTokenAccepted", func() {
+		const issuer = "https://issuer.example.com"
+
+		var (
+			ctrl        *gomock.Controller
+			shootClient *mockkubernetes.MockInterface
+			clientset   *kubernetesfake.Clientset
+			operation   *GardenerTestOperation
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			shootClient = mockkubernetes.NewMockInterface(ctrl)
+			clientset = kubernetesfake.NewSimpleClientset()
+			clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "token" {
+					return false, nil, nil
+				}
+				return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: "projected-token"}}, nil
+			})
+			shootClient.EXPECT().Kubernetes().Return(clientset).AnyTimes()
+			operation = &GardenerTestOperation{
+				Logger:      logger.AddWriter(logger.NewLogger("info"), GinkgoWriter),
+				ShootClient: shootClient,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should succeed if the verifier accepts the projected token", func() {
+			var verified []string
+			operation.OIDCTokenVerifier = func(_ context.Context, issuer, token string) error {
+				verified = append(verified, issuer+"="+token)
+				return nil
+			}
+
+			Expect(operation.AssertOIDCTokenAccepted(context.TODO(), issuer, time.Second)).To(Succeed())
+			Expect(verified).To(ConsistOf(issuer + "=projected-token"))
+		})
+
+		It("should fail if the verifier rejects the projected token", func() {
+			operation.OIDCTokenVerifier = func(context.Context, string, string) error {
+				return fmt.Errorf("audience mismatch")
+			}
+
+			Expect(operation.AssertOIDCTokenAccepted(context.TODO(), issuer, 10*time.Millisecond)).To(MatchError(ContainSubstring("audience mismatch")))
+		})
+
+		It("should verify the token against the keys published by the issuer by default", func() {
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/.well-known/openid-configuration":
+					fmt.Fprintf(w, `{"issuer":%q,"jwks_uri":%q}`, server.URL, server.URL+"/keys")
+				case "/keys":
+					fmt.Fprint(w, `{"keys":[{"kid":"key-1"}]}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			encode := func(segment string) string {
+				return base64.RawURLEncoding.EncodeToString([]byte(segment))
+			}
+			token := func(keyID, issuer string) string {
+				return encode(fmt.Sprintf(`{"kid":%q}`, keyID)) + "." + encode(fmt.Sprintf(`{"iss":%q}`, issuer)) + ".signature"
+			}
+
+			clientset.PrependReactor("create", "serviceaccounts", func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: token("key-1", server.URL)}}, nil
+			})
+			Expect(operation.AssertOIDCTokenAccepted(context.TODO(), server.URL, time.Second)).To(Succeed())
+
+			clientset.PrependReactor("create", "serviceaccounts", func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: token("key-2", server.URL)}}, nil
+			})
+			Expect(operation.AssertOIDCTokenAccepted(context.TODO(), server.URL, 10*time.Millisecond)).To(MatchError(ContainSubstring(`key "key-2" the token was signed with is not published by the issuer`)))
+		})
+	})
 })
+
+// podLogsCoreV1 is a CoreV1Interface which only serves the given pod interface.
+type podLogsCoreV1 struct {
+	corev1client.CoreV1Interface
+	pods corev1client.PodInterface
+}
+
+func (c *podLogsCoreV1) Pods(string) corev1client.PodInterface {
+	return c.pods
+}
+
+// recreatingPodClient is a client which immediately recreates deleted pods with a new UID and a ready status, like
+// the stateful set controller would do.
+type recreatingPodClient struct {
+	client.Client
+}
+
+func (c *recreatingPodClient) Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOptionFunc) error {
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+	return c.Client.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace, UID: "new"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	})
+}
+
+// rotatingShootClient is a client which immediately processes the rotation of the kubeconfig credentials of updated
+// shoots, like the Gardener would do. The reconciliation after the rotation ends in the given state.
+type rotatingShootClient struct {
+	client.Client
+	state     gardencorev1alpha1.LastOperationState
+	rotations int
+}
+
+func (c *rotatingShootClient) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOptionFunc) error {
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if !ok || shoot.Annotations[common.ShootOperation] != common.ShootOperationRotateKubeconfigCredentials {
+		return c.Client.Update(ctx, obj, opts...)
+	}
+
+	c.rotations++
+	rotated := shoot.DeepCopy()
+	delete(rotated.Annotations, common.ShootOperation)
+	rotated.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+		Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+		State: c.state,
+	}
+	return c.Client.Update(ctx, rotated, opts...)
+}
+
+// reschedulingPodClient is a client which immediately recreates deleted pods owned by a deployment on the given node,
+// like the deployment controller and the scheduler would do.
+type reschedulingPodClient struct {
+	client.Client
+	nodeName string
+}
+
+func (c *reschedulingPodClient) Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOptionFunc) error {
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || len(pod.Labels) == 0 {
+		return nil
+	}
+	return c.Client.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: pod.Name + "-new", Namespace: pod.Namespace, Labels: pod.Labels},
+		Spec:       corev1.PodSpec{NodeName: c.nodeName},
+		Status:     pod.Status,
+	})
+}
+
+// fakeResolver is a DNS resolver serving static records.
+type fakeResolver struct {
+	hosts  map[string][]string
+	cnames map[string]string
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	addresses, ok := r.hosts[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", host)
+	}
+	return addresses, nil
+}
+
+func (r *fakeResolver) LookupCNAME(_ context.Context, host string) (string, error) {
+	cname, ok := r.cnames[host]
+	if !ok {
+		return "", fmt.Errorf("no such host %s", host)
+	}
+	return cname, nil
+}
+
+// fakeAuditLogBackend is an audit log backend which received an audit log entry for every config map existing in
+// the given client.
+type fakeAuditLogBackend struct {
+	client  client.Client
+	queried []AuditEvent
+}
+
+func (b *fakeAuditLogBackend) ContainsAuditEvent(ctx context.Context, event AuditEvent) (bool, error) {
+	b.queried = append(b.queried, event)
+	if b.client == nil || event.Resource != "configmaps" {
+		return false, nil
+	}
+
+	err := b.client.Get(ctx, client.ObjectKey{Namespace: event.Namespace, Name: event.Name}, &corev1.ConfigMap{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// fakePodExecutor simulates the isolation probes by reporting the hosts in reachable as reachable. It records the
+// executed commands prefixed with the pod and container they were executed in.
+type fakePodExecutor struct {
+	reachable map[string]bool
+	err       error
+	commands  []string
+}
+
+func (e *fakePodExecutor) Execute(_ context.Context, _, name, containerName, command string) (io.Reader, error) {
+	e.commands = append(e.commands, fmt.Sprintf("%s/%s: %s", name, containerName, command))
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	if host := strings.Fields(command)[4]; e.reachable[host] {
+		return strings.NewReader("reachable\n"), nil
+	}
+	return strings.NewReader("not-reachable\n"), nil
+}
+
+// fakeLoadBalancerHealthChecker reports a fixed backend health and records the services it was asked for.
+type fakeLoadBalancerHealthChecker struct {
+	healthy, unhealthy int
+	checked            []string
+}
+
+func (c *fakeLoadBalancerHealthChecker) BackendHealth(_ context.Context, service *corev1.Service) (int, int, error) {
+	c.checked = append(c.checked, service.Name)
+	return c.healthy, c.unhealthy, nil
+}
+
+// startingShootClient is a client which immediately reports created pods as ready, like the scheduler and the kubelet
+// would do. Created persistent volume claims are bound if bindVolumeClaims is set.
+type startingShootClient struct {
+	client.Client
+	bindVolumeClaims bool
+}
+
+func (c *startingShootClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOptionFunc) error {
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		o.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	case *corev1.PersistentVolumeClaim:
+		if c.bindVolumeClaims {
+			o.Status.Phase = corev1.ClaimBound
+		}
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+// staticPodExecutor is a pod executor which fails every command with the given error, if set. It records the executed
+// commands prefixed with the pod and container they were executed in.
+type staticPodExecutor struct {
+	err      error
+	commands []string
+}
+
+func (e *staticPodExecutor) Execute(_ context.Context, _, name, containerName, command string) (io.Reader, error) {
+	e.commands = append(e.commands, fmt.Sprintf("%s/%s: %s", name, containerName, command))
+	if e.err != nil {
+		return nil, e.err
+	}
+	return strings.NewReader(""), nil
+}
+
+// volumeDataPodExecutor simulates writing (`echo <data> > <file>`) and reading (`cat <file>`) files of a volume. If lossy
+// is set, written data is discarded. It records the executed commands prefixed with the pod and container they were
+// executed in.
+type volumeDataPodExecutor struct {
+	files    map[string]string
+	lossy    bool
+	err      error
+	commands []string
+}
+
+func (e *volumeDataPodExecutor) Execute(_ context.Context, _, name, containerName, command string) (io.Reader, error) {
+	e.commands = append(e.commands, fmt.Sprintf("%s/%s: %s", name, containerName, command))
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	fields := strings.Fields(command)
+	switch {
+	case fields[0] == "cat":
+		return strings.NewReader(e.files[fields[1]] + "\n"), nil
+	case fields[0] == "echo" && !e.lossy:
+		e.files[fields[3]] = fields[1]
+	}
+	return strings.NewReader(""), nil
+}
+
+// schedulingShootClient is a client which simulates the scheduler: shoots fetched after scheduleAfter are assigned to
+// the seed with the given name. It records the last created shoot.
+type schedulingShootClient struct {
+	client.Client
+	seedName      string
+	scheduleAfter time.Time
+	created       *gardenv1beta1.Shoot
+}
+
+func (c *schedulingShootClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOptionFunc) error {
+	if shoot, ok := obj.(*gardenv1beta1.Shoot); ok {
+		c.created = shoot.DeepCopy()
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *schedulingShootClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if err := c.Client.Get(ctx, key, obj); err != nil {
+		return err
+	}
+	if shoot, ok := obj.(*gardenv1beta1.Shoot); ok && time.Now().After(c.scheduleAfter) && shoot.Spec.Cloud.Seed == nil {
+		shoot.Spec.Cloud.Seed = &c.seedName
+		return c.Client.Update(ctx, shoot)
+	}
+	return nil
+}
+
+// rollingNodeClient is a client which simulates the rollout of nodes: every list of nodes returns the next of the given
+// steps (the last one is returned repeatedly).
+type rollingNodeClient struct {
+	client.Client
+	steps [][]corev1.Node
+	step  int
+}
+
+func (c *rollingNodeClient) List(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+	nodes, ok := list.(*corev1.NodeList)
+	if !ok {
+		return c.Client.List(ctx, list, opts...)
+	}
+
+	nodes.Items = c.steps[c.step]
+	if c.step < len(c.steps)-1 {
+		c.step++
+	}
+	return nil
+}
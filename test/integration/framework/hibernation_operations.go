@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HibernateShootAndWait enables the hibernation of the shoot and waits until all nodes of the shoot are gone and the
+// shoot reports to be hibernated. It returns an error if the shoot is not hibernated within the given timeout.
+func (o *GardenerTestOperation) HibernateShootAndWait(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := o.setShootHibernation(ctx, true); err != nil {
+		return err
+	}
+
+	// The nodes are removed before the control plane of the shoot is scaled down, hence they have to be checked first.
+	nodesRemoved := false
+	return retry.Until(ctx, defaultPollInterval, func(ctx context.Context) (done bool, err error) {
+		if !nodesRemoved {
+			nodes := &corev1.NodeList{}
+			if err := o.ShootClient.Client().List(ctx, nodes); err != nil {
+				return retry.MinorError(err)
+			}
+			if len(nodes.Items) > 0 {
+				o.Logger.Infof("Waiting for %d node(s) of shoot %s to be removed", len(nodes.Items), o.Shoot.Name)
+				return retry.MinorError(fmt.Errorf("shoot %s still has %d node(s)", o.Shoot.Name, len(nodes.Items)))
+			}
+			nodesRemoved = true
+		}
+
+		shoot := &gardenv1beta1.Shoot{}
+		if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: o.Shoot.Namespace, Name: o.Shoot.Name}, shoot); err != nil {
+			return retry.MinorError(err)
+		}
+		if shoot.Status.IsHibernated == nil || !*shoot.Status.IsHibernated {
+			o.Logger.Infof("Waiting for shoot %s to be hibernated", o.Shoot.Name)
+			return retry.MinorError(fmt.Errorf("shoot %s is not yet hibernated", o.Shoot.Name))
+		}
+
+		o.Logger.Infof("Shoot %s was hibernated successfully!", o.Shoot.Name)
+		return retry.Ok()
+	})
+}
+
+// WakeUpShootAndWait disables the hibernation of the shoot and waits until the shoot reports to be awake and has
+// healthy nodes again. It returns an error if the shoot is not woken up within the given timeout.
+func (o *GardenerTestOperation) WakeUpShootAndWait(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := o.setShootHibernation(ctx, false); err != nil {
+		return err
+	}
+
+	return retry.Until(ctx, defaultPollInterval, func(ctx context.Context) (done bool, err error) {
+		shoot := &gardenv1beta1.Shoot{}
+		if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: o.Shoot.Namespace, Name: o.Shoot.Name}, shoot); err != nil {
+			return retry.MinorError(err)
+		}
+		if shoot.Status.IsHibernated != nil && *shoot.Status.IsHibernated {
+			o.Logger.Infof("Waiting for shoot %s to be woken up", o.Shoot.Name)
+			return retry.MinorError(fmt.Errorf("shoot %s is still hibernated", o.Shoot.Name))
+		}
+
+		nodes := &corev1.NodeList{}
+		if err := o.ShootClient.Client().List(ctx, nodes); err != nil {
+			return retry.MinorError(err)
+		}
+		if len(nodes.Items) == 0 {
+			o.Logger.Infof("Waiting for nodes of shoot %s to be created", o.Shoot.Name)
+			return retry.MinorError(fmt.Errorf("shoot %s has no nodes yet", o.Shoot.Name))
+		}
+		for _, node := range nodes.Items {
+			if err := health.CheckNode(&node); err != nil {
+				o.Logger.Infof("Waiting for node %s of shoot %s to be healthy", node.Name, o.Shoot.Name)
+				return retry.MinorError(fmt.Errorf("node %s is not healthy: %v", node.Name, err))
+			}
+		}
+
+		o.Logger.Infof("Shoot %s has been woken up successfully!", o.Shoot.Name)
+		return retry.Ok()
+	})
+}
+
+// setShootHibernation enables or disables the hibernation of the shoot in the garden cluster.
+func (o *GardenerTestOperation) setShootHibernation(ctx context.Context, enabled bool) error {
+	shoot := &gardenv1beta1.Shoot{}
+	if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: o.Shoot.Namespace, Name: o.Shoot.Name}, shoot); err != nil {
+		return err
+	}
+
+	setHibernation(shoot, enabled)
+	if err := o.GardenClient.Client().Update(ctx, shoot); err != nil {
+		return err
+	}
+
+	o.Shoot = shoot
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// StreamGardenComponentLogs tails the logs of a running pod of the given Gardener control plane component
+// (e.g. "controller-manager" or "scheduler") in the garden namespace. It returns the first log line for which
+// <matcher> returns true, or an error if the log stream ends or the context expires before such a line appears.
+func (o *GardenerTestOperation) StreamGardenComponentLogs(ctx context.Context, component string, matcher func(line string) bool) (string, error) {
+	componentLabels := labels.SelectorFromSet(labels.Set(map[string]string{
+		"app":  "gardener",
+		"role": component,
+	}))
+
+	pod, err := o.GetFirstRunningPodWithLabels(ctx, componentLabels, common.GardenNamespace, o.GardenClient)
+	if err != nil {
+		return "", err
+	}
+
+	stream, err := o.GardenClient.Kubernetes().CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Follow: true}).Context(ctx).Stream()
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+
+	return waitForMatchingLogLine(ctx, stream, matcher)
+}
+
+// waitForMatchingLogLine reads the given log stream line by line until a line matches, the stream ends or the
+// context expires.
+func waitForMatchingLogLine(ctx context.Context, stream io.Reader, matcher func(line string) bool) (string, error) {
+	type result struct {
+		line string
+		err  error
+	}
+
+	resultCh := make(chan result, 1)
+	go func() {
+		scanner := bufio.NewScanner(stream)
+		for scanner.Scan() {
+			if line := scanner.Text(); matcher(line) {
+				resultCh <- result{line: line}
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			resultCh <- result{err: err}
+			return
+		}
+		resultCh <- result{err: fmt.Errorf("log stream ended without a matching line")}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("no matching log line found: %v", ctx.Err())
+	case res := <-resultCh:
+		return res.line, res.err
+	}
+}
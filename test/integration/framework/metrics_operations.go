@@ -0,0 +1,94 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	prometheusServiceName = "prometheus-web"
+	prometheusServicePort = "80"
+	prometheusQueryPath   = "/api/v1/query"
+)
+
+// DefaultControlPlaneMetrics are the series which are required by AssertControlPlaneMetricsAvailable if no other
+// series are given. They are only present if the Prometheus of the shoot can scrape the respective control plane
+// component.
+var DefaultControlPlaneMetrics = []string{
+	`apiserver_request_total{job="kube-apiserver"}`,
+	`etcd_object_counts{job="kube-apiserver"}`,
+	`etcd_server_has_leader{job="kube-etcd3"}`,
+	`rest_client_requests_total{job="kube-controller-manager"}`,
+}
+
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// AssertControlPlaneMetricsAvailable queries the Prometheus in the shoot namespace of the seed until all the given
+// series are present. If no series are given, the DefaultControlPlaneMetrics are required. It returns an error
+// listing the missing series if they are not available within the given timeout.
+func (o *GardenerTestOperation) AssertControlPlaneMetricsAvailable(ctx context.Context, timeout time.Duration, series ...string) error {
+	if len(series) == 0 {
+		series = DefaultControlPlaneMetrics
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (bool, error) {
+		var missing []string
+		for _, s := range series {
+			available, err := o.controlPlaneMetricAvailable(s)
+			if err != nil {
+				return retry.MinorError(err)
+			}
+			if !available {
+				missing = append(missing, s)
+			}
+		}
+
+		if len(missing) > 0 {
+			o.Logger.Infof("Waiting for control plane metrics %s to be available", strings.Join(missing, ", "))
+			return retry.MinorError(fmt.Errorf("control plane metrics %s are not available", strings.Join(missing, ", ")))
+		}
+		return retry.Ok()
+	})
+}
+
+func (o *GardenerTestOperation) controlPlaneMetricAvailable(series string) (bool, error) {
+	body, err := o.SeedClient.Kubernetes().CoreV1().Services(o.ShootSeedNamespace()).
+		ProxyGet("http", prometheusServiceName, prometheusServicePort, prometheusQueryPath, map[string]string{"query": series}).
+		DoRaw()
+	if err != nil {
+		return false, fmt.Errorf("could not query prometheus for %s: %v", series, err)
+	}
+
+	response := &prometheusQueryResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return false, fmt.Errorf("could not decode prometheus response for %s: %v", series, err)
+	}
+	if response.Status != "success" {
+		return false, fmt.Errorf("prometheus query for %s returned status %q", series, response.Status)
+	}
+	return len(response.Data.Result) > 0, nil
+}
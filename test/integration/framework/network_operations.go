@@ -0,0 +1,114 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// isolationProbeContainer is the container of the control plane pod from which the isolation probes are run.
+	isolationProbeContainer = "prometheus"
+	// isolationProbeTimeout is the timeout in seconds of a single connection attempt of the isolation probes.
+	isolationProbeTimeout = 3
+	// isolationProbeReachable is printed by the isolation probes if the connection attempt succeeded.
+	isolationProbeReachable = "reachable"
+)
+
+// isolationProbeLabels are the labels of the control plane pod from which the isolation probes are run.
+var isolationProbeLabels = labels.SelectorFromSet(labels.Set{"app": "prometheus", "role": "monitoring"})
+
+// AssertShootIsolationOnSeed verifies that the control plane of the shoot cannot reach the control plane of another
+// shoot on the same seed. It tries to open a TCP connection from the prometheus pod in the seed namespace of the shoot
+// to every port of every service in the given seed namespace of the other shoot. It returns an error listing all
+// services that could be reached.
+func (o *GardenerTestOperation) AssertShootIsolationOnSeed(ctx context.Context, otherShootNamespace string) error {
+	namespace := o.ShootSeedNamespace()
+	if otherShootNamespace == namespace {
+		return fmt.Errorf("namespace %s is the seed namespace of shoot %s itself", otherShootNamespace, o.Shoot.Name)
+	}
+
+	pod, err := o.GetFirstRunningPodWithLabels(ctx, isolationProbeLabels, namespace, o.SeedClient)
+	if err != nil {
+		return fmt.Errorf("could not find a running pod with labels %s to probe the isolation from in namespace %s: %v", isolationProbeLabels, namespace, err)
+	}
+
+	services := &corev1.ServiceList{}
+	if err := o.SeedClient.Client().List(ctx, services, client.InNamespace(otherShootNamespace)); err != nil {
+		return err
+	}
+
+	executor := o.PodExecutor
+	if executor == nil {
+		executor = kubernetes.NewPodExecutor(o.SeedClient.RESTConfig())
+	}
+
+	var leaks []string
+	for _, service := range services.Items {
+		if len(service.Spec.ClusterIP) == 0 || service.Spec.ClusterIP == corev1.ClusterIPNone {
+			continue
+		}
+
+		for _, port := range service.Spec.Ports {
+			if len(port.Protocol) > 0 && port.Protocol != corev1.ProtocolTCP {
+				continue
+			}
+
+			address := net.JoinHostPort(service.Spec.ClusterIP, strconv.Itoa(int(port.Port)))
+			reachable, err := probeTCPConnection(ctx, executor, pod, service.Spec.ClusterIP, port.Port)
+			if err != nil {
+				return fmt.Errorf("could not probe service %s/%s at %s from pod %s/%s: %v", service.Namespace, service.Name, address, pod.Namespace, pod.Name, err)
+			}
+			if reachable {
+				leaks = append(leaks, fmt.Sprintf("%s/%s at %s", service.Namespace, service.Name, address))
+			}
+		}
+	}
+
+	if len(leaks) > 0 {
+		return fmt.Errorf("control plane of shoot %s (pod %s/%s) can reach the following services of the control plane in namespace %s: %s", o.Shoot.Name, pod.Namespace, pod.Name, otherShootNamespace, strings.Join(leaks, ", "))
+	}
+
+	o.Logger.Infof("Control plane of shoot %s is isolated from the control plane in namespace %s (%d services probed)", o.Shoot.Name, otherShootNamespace, len(services.Items))
+	return nil
+}
+
+// probeTCPConnection tries to open a TCP connection to the given host and port from within the given pod and returns
+// whether it succeeded.
+func probeTCPConnection(ctx context.Context, executor kubernetes.PodExecutor, pod *corev1.Pod, host string, port int32) (bool, error) {
+	command := fmt.Sprintf("nc -z -w %d %s %d && echo %s || echo not-%s", isolationProbeTimeout, host, port, isolationProbeReachable, isolationProbeReachable)
+
+	reader, err := executor.Execute(ctx, pod.Namespace, pod.Name, isolationProbeContainer, command)
+	if err != nil {
+		return false, err
+	}
+
+	output, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(output)) == isolationProbeReachable, nil
+}
@@ -0,0 +1,390 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// drainProbeInterval is the interval in which the availability of a workload is probed while a node is drained.
+const drainProbeInterval = time.Second
+
+// AssertWorkloadSurvivesNodeDrain cordons and drains a node of the shoot hosting a pod of the given deployment. It
+// continuously probes the deployment and fails as soon as it has no available replica anymore. It succeeds once all
+// pods of the deployment have left the drained node and the deployment is healthy again. The node is uncordoned
+// afterwards.
+func (o *GardenerTestOperation) AssertWorkloadSurvivesNodeDrain(ctx context.Context, namespace, name string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deployment := &appsv1.Deployment{}
+	if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, deployment); err != nil {
+		return fmt.Errorf("could not get deployment %s/%s: %v", namespace, name, err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	pod, err := o.GetFirstRunningPodWithLabels(ctx, selector, namespace, o.ShootClient)
+	if err != nil {
+		return fmt.Errorf("could not find a running pod of deployment %s/%s: %v", namespace, name, err)
+	}
+	nodeName := pod.Spec.NodeName
+
+	if err := o.setNodeUnschedulable(ctx, nodeName, true); err != nil {
+		return fmt.Errorf("could not cordon node %s: %v", nodeName, err)
+	}
+	defer func() {
+		// The context of the assertion might already be expired.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if err := o.setNodeUnschedulable(ctx, nodeName, false); err != nil {
+			o.Logger.Errorf("Could not uncordon node %s: %v", nodeName, err)
+		}
+	}()
+
+	o.Logger.Infof("Draining node %s hosting pod %s of deployment %s/%s", nodeName, pod.Name, namespace, name)
+	if err := o.drainNode(ctx, nodeName); err != nil {
+		return fmt.Errorf("could not drain node %s: %v", nodeName, err)
+	}
+
+	return retry.Until(ctx, drainProbeInterval, func(ctx context.Context) (done bool, err error) {
+		deployment := &appsv1.Deployment{}
+		if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, deployment); err != nil {
+			return retry.MinorError(err)
+		}
+		if deployment.Status.AvailableReplicas == 0 {
+			return retry.SevereError(fmt.Errorf("deployment %s/%s became unavailable while draining node %s", namespace, name, nodeName))
+		}
+
+		pods, err := o.GetPodsByLabels(ctx, selector, o.ShootClient, namespace)
+		if err != nil {
+			return retry.MinorError(err)
+		}
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName == nodeName {
+				o.Logger.Infof("Waiting for pod %s to leave the drained node %s", pod.Name, nodeName)
+				return retry.MinorError(fmt.Errorf("pod %s of deployment %s/%s is still running on the drained node %s", pod.Name, namespace, name, nodeName))
+			}
+		}
+
+		if err := health.CheckDeployment(deployment); err != nil {
+			o.Logger.Infof("Waiting for deployment %s/%s to be healthy after draining node %s", namespace, name, nodeName)
+			return retry.MinorError(fmt.Errorf("deployment %s/%s is not healthy after draining node %s: %v", namespace, name, nodeName, err))
+		}
+
+		o.Logger.Infof("Deployment %s/%s survived the drain of node %s!", namespace, name, nodeName)
+		return retry.Ok()
+	})
+}
+
+// AssertPDBRespectedDuringDrain cordons and drains a node of the shoot hosting a pod protected by the given pod
+// disruption budget. It continuously counts the ready pods matching the selector of the budget and fails as soon as
+// they fall below its `minAvailable`. Percentages are resolved against the number of matching pods before the drain.
+// It succeeds once all protected pods have left the drained node and at least `minAvailable` of them are ready. The
+// node is uncordoned afterwards.
+func (o *GardenerTestOperation) AssertPDBRespectedDuringDrain(ctx context.Context, namespace, pdbName string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pdb := &policyv1beta1.PodDisruptionBudget{}
+	if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: pdbName}, pdb); err != nil {
+		return fmt.Errorf("could not get pod disruption budget %s/%s: %v", namespace, pdbName, err)
+	}
+	if pdb.Spec.MinAvailable == nil {
+		return fmt.Errorf("pod disruption budget %s/%s does not specify minAvailable", namespace, pdbName)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	pods, err := o.GetPodsByLabels(ctx, selector, o.ShootClient, namespace)
+	if err != nil {
+		return fmt.Errorf("could not list pods protected by pod disruption budget %s/%s: %v", namespace, pdbName, err)
+	}
+	minAvailable, err := intstr.GetValueFromIntOrPercent(pdb.Spec.MinAvailable, len(pods.Items), true)
+	if err != nil {
+		return fmt.Errorf("invalid minAvailable of pod disruption budget %s/%s: %v", namespace, pdbName, err)
+	}
+
+	pod, err := o.GetFirstRunningPodWithLabels(ctx, selector, namespace, o.ShootClient)
+	if err != nil {
+		return fmt.Errorf("could not find a running pod protected by pod disruption budget %s/%s: %v", namespace, pdbName, err)
+	}
+	nodeName := pod.Spec.NodeName
+
+	if err := o.setNodeUnschedulable(ctx, nodeName, true); err != nil {
+		return fmt.Errorf("could not cordon node %s: %v", nodeName, err)
+	}
+	defer func() {
+		// The context of the assertion might already be expired.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if err := o.setNodeUnschedulable(ctx, nodeName, false); err != nil {
+			o.Logger.Errorf("Could not uncordon node %s: %v", nodeName, err)
+		}
+	}()
+
+	o.Logger.Infof("Draining node %s hosting pod %s protected by pod disruption budget %s/%s", nodeName, pod.Name, namespace, pdbName)
+	if err := o.drainNode(ctx, nodeName); err != nil {
+		return fmt.Errorf("could not drain node %s: %v", nodeName, err)
+	}
+
+	return retry.Until(ctx, drainProbeInterval, func(ctx context.Context) (done bool, err error) {
+		pods, err := o.GetPodsByLabels(ctx, selector, o.ShootClient, namespace)
+		if err != nil {
+			return retry.MinorError(err)
+		}
+
+		var readyPods int
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp == nil && health.IsPodReady(&pod) {
+				readyPods++
+			}
+		}
+		if readyPods < minAvailable {
+			return retry.SevereError(fmt.Errorf("pod disruption budget %s/%s was violated while draining node %s: only %d ready pod(s), but minAvailable is %d", namespace, pdbName, nodeName, readyPods, minAvailable))
+		}
+
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName == nodeName {
+				o.Logger.Infof("Waiting for pod %s to leave the drained node %s", pod.Name, nodeName)
+				return retry.MinorError(fmt.Errorf("pod %s protected by pod disruption budget %s/%s is still running on the drained node %s", pod.Name, namespace, pdbName, nodeName))
+			}
+		}
+
+		o.Logger.Infof("Pod disruption budget %s/%s was respected during the drain of node %s!", namespace, pdbName, nodeName)
+		return retry.Ok()
+	})
+}
+
+// AssertPodsSpreadAcrossZones checks that the pods of the shoot matching the given label selector run on nodes in at
+// least `minZones` distinct zones, as determined by the zone label of the nodes. Pods which are not yet scheduled to a
+// node are not considered.
+func (o *GardenerTestOperation) AssertPodsSpreadAcrossZones(ctx context.Context, namespace string, labelSelector labels.Selector, minZones int) error {
+	pods, err := o.GetPodsByLabels(ctx, labelSelector, o.ShootClient, namespace)
+	if err != nil {
+		return fmt.Errorf("could not list pods matching %q in namespace %s: %v", labelSelector, namespace, err)
+	}
+
+	var (
+		zones     = sets.NewString()
+		nodeZones = map[string]string{}
+	)
+
+	for _, pod := range pods.Items {
+		nodeName := pod.Spec.NodeName
+		if len(nodeName) == 0 {
+			continue
+		}
+
+		zone, ok := nodeZones[nodeName]
+		if !ok {
+			node := &corev1.Node{}
+			if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+				return fmt.Errorf("could not get node %s of pod %s: %v", nodeName, pod.Name, err)
+			}
+			zone = node.Labels[corev1.LabelZoneFailureDomain]
+			nodeZones[nodeName] = zone
+		}
+
+		if len(zone) == 0 {
+			o.Logger.Infof("Node %s of pod %s has no zone label, ignoring it", nodeName, pod.Name)
+			continue
+		}
+		zones.Insert(zone)
+	}
+
+	if zones.Len() < minZones {
+		return fmt.Errorf("pods matching %q in namespace %s run in %d zone(s) [%s] but at least %d zone(s) are required", labelSelector, namespace, zones.Len(), strings.Join(zones.List(), ", "), minZones)
+	}
+
+	o.Logger.Infof("Pods matching %q in namespace %s run in %d zone(s) [%s]", labelSelector, namespace, zones.Len(), strings.Join(zones.List(), ", "))
+	return nil
+}
+
+// AssertNodeConditionEventually waits until the condition of the given type of the given node of the shoot reaches the
+// given status, e.g. to verify that the node-problem-detector reports a problem of the node. It returns an error if the
+// condition does not reach the status within the given timeout.
+func (o *GardenerTestOperation) AssertNodeConditionEventually(ctx context.Context, nodeName string, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, timeout time.Duration) error {
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		node := &corev1.Node{}
+		if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+			return retry.MinorError(fmt.Errorf("could not get node %s: %v", nodeName, err))
+		}
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type != conditionType {
+				continue
+			}
+			if condition.Status == status {
+				o.Logger.Infof("Condition %s of node %s has status %s", conditionType, nodeName, status)
+				return retry.Ok()
+			}
+			o.Logger.Infof("Waiting for condition %s of node %s to have status %s (current: %s)", conditionType, nodeName, status, condition.Status)
+			return retry.MinorError(fmt.Errorf("condition %s of node %s has status %s instead of %s", conditionType, nodeName, condition.Status, status))
+		}
+
+		o.Logger.Infof("Waiting for node %s to report condition %s", nodeName, conditionType)
+		return retry.MinorError(fmt.Errorf("node %s does not report condition %s", nodeName, conditionType))
+	})
+}
+
+const (
+	// rolloutProbeInterval is the interval in which the nodes of a shoot are probed while they are rolled.
+	rolloutProbeInterval = time.Second
+	// rolloutMaxUnavailableNodes is the maximum number of nodes a shoot may lack (compared to the number of ready nodes
+	// before the rollout) at the same time while its nodes are rolled.
+	rolloutMaxUnavailableNodes = 1
+)
+
+// AssertMachineImageUpdateRollsNodes updates the machine image of the shoot (the default one and the one of all worker
+// pools using an image of the same name) to the given image and waits until all nodes existing before the update have
+// been replaced by ready nodes. It continuously probes the nodes of the shoot meanwhile and fails as soon as more than
+// one node is unavailable at the same time (i.e. the shoot has at least two ready nodes less than before the update),
+// as the nodes are expected to be replaced one-by-one.
+func (o *GardenerTestOperation) AssertMachineImageUpdateRollsNodes(ctx context.Context, newImage *gardenv1beta1.ShootMachineImage, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	nodes := &corev1.NodeList{}
+	if err := o.ShootClient.Client().List(ctx, nodes); err != nil {
+		return fmt.Errorf("could not list nodes of shoot %s: %v", o.Shoot.Name, err)
+	}
+	oldNodes := sets.NewString()
+	for _, node := range nodes.Items {
+		oldNodes.Insert(node.Name)
+	}
+
+	shoot := &gardenv1beta1.Shoot{}
+	if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: o.Shoot.Namespace, Name: o.Shoot.Name}, shoot); err != nil {
+		return err
+	}
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return err
+	}
+	helper.UpdateDefaultMachineImage(cloudProvider, newImage)(&shoot.Spec.Cloud)
+	helper.UpdateMachineImages(cloudProvider, []*gardenv1beta1.ShootMachineImage{newImage})(&shoot.Spec.Cloud)
+	if err := o.GardenClient.Client().Update(ctx, shoot); err != nil {
+		return fmt.Errorf("could not update machine image of shoot %s to %s %s: %v", o.Shoot.Name, newImage.Name, newImage.Version, err)
+	}
+	o.Shoot = shoot
+
+	o.Logger.Infof("Updated machine image of shoot %s to %s %s, waiting for its %d node(s) to be rolled", o.Shoot.Name, newImage.Name, newImage.Version, oldNodes.Len())
+	return retry.Until(ctx, rolloutProbeInterval, func(ctx context.Context) (done bool, err error) {
+		nodes := &corev1.NodeList{}
+		if err := o.ShootClient.Client().List(ctx, nodes); err != nil {
+			return retry.MinorError(err)
+		}
+
+		var (
+			remainingOldNodes []string
+			notReadyNodes     []string
+			readyNodes        int
+		)
+		for _, node := range nodes.Items {
+			if oldNodes.Has(node.Name) {
+				remainingOldNodes = append(remainingOldNodes, node.Name)
+			}
+			if err := health.CheckNode(&node); err != nil {
+				notReadyNodes = append(notReadyNodes, node.Name)
+				continue
+			}
+			readyNodes++
+		}
+
+		if unavailable := oldNodes.Len() - readyNodes; unavailable > rolloutMaxUnavailableNodes {
+			return retry.SevereError(fmt.Errorf("%d nodes of shoot %s are unavailable at the same time while rolling the nodes (at most %d allowed), NotReady nodes: [%s]", unavailable, o.Shoot.Name, rolloutMaxUnavailableNodes, strings.Join(notReadyNodes, ", ")))
+		}
+		if len(remainingOldNodes) > 0 {
+			o.Logger.Infof("Waiting for %d node(s) of shoot %s to be replaced: %s", len(remainingOldNodes), o.Shoot.Name, strings.Join(remainingOldNodes, ", "))
+			return retry.MinorError(fmt.Errorf("nodes of shoot %s have not been replaced yet: %s", o.Shoot.Name, strings.Join(remainingOldNodes, ", ")))
+		}
+		if len(notReadyNodes) > 0 || readyNodes < oldNodes.Len() {
+			o.Logger.Infof("Waiting for %d node(s) of shoot %s to be ready (currently %d)", oldNodes.Len(), o.Shoot.Name, readyNodes)
+			return retry.MinorError(fmt.Errorf("only %d of %d nodes of shoot %s are ready after rolling the nodes", readyNodes, oldNodes.Len(), o.Shoot.Name))
+		}
+
+		o.Logger.Infof("All nodes of shoot %s were rolled to machine image %s %s!", o.Shoot.Name, newImage.Name, newImage.Version)
+		return retry.Ok()
+	})
+}
+
+// setNodeUnschedulable cordons or uncordons the given node of the shoot.
+func (o *GardenerTestOperation) setNodeUnschedulable(ctx context.Context, nodeName string, unschedulable bool) error {
+	node := &corev1.Node{}
+	if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return err
+	}
+
+	node.Spec.Unschedulable = unschedulable
+	return o.ShootClient.Client().Update(ctx, node)
+}
+
+// drainNode deletes all pods running on the given node of the shoot except for DaemonSet and mirror pods, similar to
+// `kubectl drain --disable-eviction`.
+func (o *GardenerTestOperation) drainNode(ctx context.Context, nodeName string) error {
+	pods := &corev1.PodList{}
+	if err := o.ShootClient.Client().List(ctx, pods); err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != nodeName || isDaemonSetOrMirrorPod(&pod) {
+			continue
+		}
+		if err := o.ShootClient.Client().Delete(ctx, pod.DeepCopy()); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// isDaemonSetOrMirrorPod returns true if the given pod is managed by a DaemonSet or is a mirror pod of a static pod,
+// as such pods are not removed when draining a node.
+func isDaemonSetOrMirrorPod(pod *corev1.Pod) bool {
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return true
+	}
+	for _, ownerReference := range pod.OwnerReferences {
+		if ownerReference.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
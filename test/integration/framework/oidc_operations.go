@@ -0,0 +1,149 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// oidcTokenServiceAccount is the service account of the shoot a token is requested for to assert the OIDC federation.
+	oidcTokenServiceAccount = "default"
+	// oidcTokenExpirationSeconds is the expiration of the tokens requested to assert the OIDC federation.
+	oidcTokenExpirationSeconds int64 = 600
+)
+
+// AssertOIDCTokenAccepted requests a projected token for the default service account of the shoot and verifies that
+// a relying party federating with the given issuer accepts it, until it is accepted or the timeout is reached. The
+// token is verified by the OIDCTokenVerifier of the operation (see verifyOIDCTokenWithDiscovery for the default).
+func (o *GardenerTestOperation) AssertOIDCTokenAccepted(ctx context.Context, issuer string, timeout time.Duration) error {
+	verify := o.OIDCTokenVerifier
+	if verify == nil {
+		verify = verifyOIDCTokenWithDiscovery
+	}
+
+	expirationSeconds := oidcTokenExpirationSeconds
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		tokenRequest, err := o.ShootClient.Kubernetes().CoreV1().ServiceAccounts(metav1.NamespaceDefault).CreateToken(oidcTokenServiceAccount, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				ExpirationSeconds: &expirationSeconds,
+			},
+		})
+		if err != nil {
+			o.Logger.Infof("Waiting for a token of service account %s/%s to be issued: %v", metav1.NamespaceDefault, oidcTokenServiceAccount, err)
+			return retry.MinorError(fmt.Errorf("could not request a token for service account %s/%s: %v", metav1.NamespaceDefault, oidcTokenServiceAccount, err))
+		}
+
+		if err := verify(ctx, issuer, tokenRequest.Status.Token); err != nil {
+			o.Logger.Infof("Waiting for the token of service account %s/%s to be accepted: %v", metav1.NamespaceDefault, oidcTokenServiceAccount, err)
+			return retry.MinorError(fmt.Errorf("token of service account %s/%s is not accepted for issuer %s: %v", metav1.NamespaceDefault, oidcTokenServiceAccount, issuer, err))
+		}
+
+		o.Logger.Infof("Token of service account %s/%s was accepted for issuer %s", metav1.NamespaceDefault, oidcTokenServiceAccount, issuer)
+		return retry.Ok()
+	})
+}
+
+// oidcDiscoveryDocument contains the fields of an OpenID provider configuration required to verify tokens.
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// verifyOIDCTokenWithDiscovery verifies the token like a relying party discovering the issuer would: the token must be
+// issued by the given issuer, the issuer must publish its discovery document, and the key the token was signed with
+// must be part of the keys published by the issuer. The signature itself is not validated.
+func verifyOIDCTokenWithDiscovery(ctx context.Context, issuer, token string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("token is not a JSON web token")
+	}
+
+	header := struct {
+		KeyID string `json:"kid"`
+	}{}
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return fmt.Errorf("could not decode token header: %v", err)
+	}
+	claims := struct {
+		Issuer string `json:"iss"`
+	}{}
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return fmt.Errorf("could not decode token claims: %v", err)
+	}
+	if claims.Issuer != issuer {
+		return fmt.Errorf("token is issued by %q instead of %q", claims.Issuer, issuer)
+	}
+
+	discovery := &oidcDiscoveryDocument{}
+	if err := getJSON(ctx, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", discovery); err != nil {
+		return fmt.Errorf("could not discover issuer: %v", err)
+	}
+	if discovery.Issuer != issuer {
+		return fmt.Errorf("discovery document states issuer %q instead of %q", discovery.Issuer, issuer)
+	}
+
+	keys := struct {
+		Keys []struct {
+			KeyID string `json:"kid"`
+		} `json:"keys"`
+	}{}
+	if err := getJSON(ctx, discovery.JWKSURI, &keys); err != nil {
+		return fmt.Errorf("could not fetch keys of issuer: %v", err)
+	}
+	for _, key := range keys.Keys {
+		if key.KeyID == header.KeyID {
+			return nil
+		}
+	}
+	return fmt.Errorf("key %q the token was signed with is not published by the issuer", header.KeyID)
+}
+
+func decodeJWTSegment(segment string, into interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, into)
+}
+
+func getJSON(ctx context.Context, url string, into interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(into)
+}
@@ -38,7 +38,11 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 )
 
-const configurationFileName = "schedulerconfiguration.yaml"
+const (
+	configurationFileName = "schedulerconfiguration.yaml"
+	// schedulingProbeInterval is the interval in which a shoot is probed while waiting for it to be scheduled.
+	schedulingProbeInterval = time.Second
+)
 
 // NewGardenSchedulerTest creates a new SchedulerGardenerTest by retrieving the ConfigMap containing the Scheduler Configuration & parsing the Scheduler Configuration
 func NewGardenSchedulerTest(ctx context.Context, shootGardenTest *ShootGardenerTest, hostKubeconfigPath string) (*SchedulerGardenerTest, error) {
@@ -256,6 +260,62 @@ func (s *SchedulerGardenerTest) WaitForShootToBeScheduled(ctx context.Context) e
 	})
 }
 
+// AssertShootScheduledWithin creates the shoot of the test without a seed and verifies that the scheduler assigns a
+// seed to it within the given duration. If it does not, the returned error contains the last event recorded for the
+// shoot, which usually states why it could not be scheduled.
+func (s *SchedulerGardenerTest) AssertShootScheduledWithin(ctx context.Context, duration time.Duration) error {
+	shoot := s.ShootGardenerTest.Shoot.DeepCopy()
+	shoot.Spec.Cloud.Seed = nil
+	if err := s.ShootGardenerTest.GardenClient.Client().Create(ctx, shoot); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	if err := retry.UntilTimeout(ctx, schedulingProbeInterval, duration, func(ctx context.Context) (bool, error) {
+		current := &gardenv1beta1.Shoot{}
+		if err := s.ShootGardenerTest.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: shoot.Name}, current); err != nil {
+			return retry.SevereError(err)
+		}
+		if !shootIsScheduledSuccessfully(&current.Spec) {
+			s.ShootGardenerTest.Logger.Infof("waiting for shoot %s to be scheduled", shoot.Name)
+			return retry.MinorError(fmt.Errorf("shoot %s is not yet scheduled", shoot.Name))
+		}
+		s.ShootGardenerTest.Logger.Infof("shoot %s was scheduled to seed %s after %s", shoot.Name, *current.Spec.Cloud.Seed, time.Since(start))
+		return retry.Ok()
+	}); err != nil {
+		lastEvent, eventErr := s.lastShootEvent(shoot)
+		if eventErr != nil {
+			return fmt.Errorf("shoot %s was not scheduled within %s: %v (could not list its events: %v)", shoot.Name, duration, err, eventErr)
+		}
+		if lastEvent == nil {
+			return fmt.Errorf("shoot %s was not scheduled within %s: %v (no event recorded)", shoot.Name, duration, err)
+		}
+		return fmt.Errorf("shoot %s was not scheduled within %s: %v (last event: %s: %s)", shoot.Name, duration, err, lastEvent.Reason, lastEvent.Message)
+	}
+	return nil
+}
+
+// lastShootEvent returns the most recent event recorded for the given shoot, or nil if there is none.
+func (s *SchedulerGardenerTest) lastShootEvent(shoot *gardenv1beta1.Shoot) (*corev1.Event, error) {
+	kind := "Shoot"
+	events := s.ShootGardenerTest.GardenClient.Kubernetes().CoreV1().Events(shoot.Namespace)
+	eventList, err := events.List(v1.ListOptions{FieldSelector: events.GetFieldSelector(&shoot.Name, &shoot.Namespace, &kind, nil).String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var lastEvent *corev1.Event
+	for i, event := range eventList.Items {
+		if event.InvolvedObject.Kind != kind || event.InvolvedObject.Name != shoot.Name {
+			continue
+		}
+		if lastEvent == nil || lastEvent.LastTimestamp.Before(&event.LastTimestamp) {
+			lastEvent = &eventList.Items[i]
+		}
+	}
+	return lastEvent, nil
+}
+
 // GenerateInvalidShoot generates a shoot with an invalid dummy name
 func (s *SchedulerGardenerTest) GenerateInvalidShoot() (*gardenv1beta1.Shoot, error) {
 	shoot := s.ShootGardenerTest.Shoot.DeepCopy()
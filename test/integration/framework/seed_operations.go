@@ -0,0 +1,183 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// seedOutageSimulatedReason is the reason of the `Available` condition of seeds whose outage is simulated.
+const seedOutageSimulatedReason = "SeedOutageSimulated"
+
+// SimulateSeedUnavailableAndAssertRecovery marks the seed of the shoot as unavailable and waits until the control plane
+// health of the shoot has been checked during the outage. Afterwards, it restores the availability of the seed and waits
+// until the control plane of the shoot is reported to be healthy again. It returns an error describing the last observed
+// condition of the shoot if it does not recover within the given timeout.
+// It is meant to be used in test environments only, as the seed controller overwrites the condition of the seed with
+// its next health check.
+func (o *GardenerTestOperation) SimulateSeedUnavailableAndAssertRecovery(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	outageStart := metav1.Now()
+	if err := o.setSeedAvailability(ctx, gardencorev1alpha1.ConditionFalse, seedOutageSimulatedReason, "The outage of the seed is simulated by an integration test."); err != nil {
+		return fmt.Errorf("could not mark seed %s as unavailable: %v", o.Seed.Name, err)
+	}
+	o.Logger.Infof("Marked seed %s as unavailable", o.Seed.Name)
+
+	if err := retry.Until(ctx, defaultPollInterval, func(ctx context.Context) (done bool, err error) {
+		condition, err := o.getShootControlPlaneCondition(ctx)
+		if err != nil {
+			return retry.MinorError(err)
+		}
+		if condition.LastUpdateTime.Before(&outageStart) {
+			o.Logger.Infof("Waiting for the control plane health of shoot %s to be checked during the outage of seed %s", o.Shoot.Name, o.Seed.Name)
+			return retry.MinorError(fmt.Errorf("control plane health of shoot %s has not been checked during the outage of seed %s (%s)", o.Shoot.Name, o.Seed.Name, conditionSummary(condition)))
+		}
+
+		o.Logger.Infof("Control plane health of shoot %s during the outage of seed %s: %s", o.Shoot.Name, o.Seed.Name, conditionSummary(condition))
+		return retry.Ok()
+	}); err != nil {
+		o.restoreSeedAvailability()
+		return err
+	}
+
+	recoveryStart := metav1.Now()
+	if err := o.setSeedAvailability(ctx, gardencorev1alpha1.ConditionTrue, "SeedOutageRecovered", "The simulated outage of the seed has ended."); err != nil {
+		return fmt.Errorf("could not mark seed %s as available: %v", o.Seed.Name, err)
+	}
+	o.Logger.Infof("Marked seed %s as available again", o.Seed.Name)
+
+	return retry.Until(ctx, defaultPollInterval, func(ctx context.Context) (done bool, err error) {
+		condition, err := o.getShootControlPlaneCondition(ctx)
+		if err != nil {
+			return retry.MinorError(err)
+		}
+		if condition.LastUpdateTime.Before(&recoveryStart) || condition.Status != gardencorev1alpha1.ConditionTrue {
+			o.Logger.Infof("Waiting for the control plane of shoot %s to recover", o.Shoot.Name)
+			return retry.MinorError(fmt.Errorf("control plane of shoot %s has not recovered from the outage of seed %s (%s)", o.Shoot.Name, o.Seed.Name, conditionSummary(condition)))
+		}
+
+		o.Logger.Infof("Control plane of shoot %s recovered from the outage of seed %s!", o.Shoot.Name, o.Seed.Name)
+		return retry.Ok()
+	})
+}
+
+// restoreSeedAvailability marks the seed as available again after a failed outage simulation. It does not use the
+// context of the simulation as it might already be expired.
+func (o *GardenerTestOperation) restoreSeedAvailability() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := o.setSeedAvailability(ctx, gardencorev1alpha1.ConditionTrue, "SeedOutageRecovered", "The simulated outage of the seed has ended."); err != nil {
+		o.Logger.Errorf("Could not mark seed %s as available again: %v", o.Seed.Name, err)
+	}
+}
+
+// setSeedAvailability updates the `Available` condition of the seed in the garden cluster.
+func (o *GardenerTestOperation) setSeedAvailability(ctx context.Context, status gardencorev1alpha1.ConditionStatus, reason, message string) error {
+	seed := &gardenv1beta1.Seed{}
+	if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Name: o.Seed.Name}, seed); err != nil {
+		return err
+	}
+
+	condition := gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardenv1beta1.SeedAvailable)
+	seed.Status.Conditions = gardencorev1alpha1helper.MergeConditions(seed.Status.Conditions, gardencorev1alpha1helper.UpdatedCondition(condition, status, reason, message))
+	return o.GardenClient.Client().Status().Update(ctx, seed)
+}
+
+// getShootControlPlaneCondition returns the `ControlPlaneHealthy` condition of the shoot in the garden cluster.
+func (o *GardenerTestOperation) getShootControlPlaneCondition(ctx context.Context) (*gardencorev1alpha1.Condition, error) {
+	shoot := &gardenv1beta1.Shoot{}
+	if err := o.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: o.Shoot.Namespace, Name: o.Shoot.Name}, shoot); err != nil {
+		return nil, err
+	}
+
+	condition := gardencorev1alpha1helper.GetCondition(shoot.Status.Conditions, gardenv1beta1.ShootControlPlaneHealthy)
+	if condition == nil {
+		return nil, fmt.Errorf("shoot %s does not report its control plane health", o.Shoot.Name)
+	}
+	return condition, nil
+}
+
+// conditionSummary returns a short description of the given condition.
+func conditionSummary(condition *gardencorev1alpha1.Condition) string {
+	return fmt.Sprintf("condition %s is %s since %s, last updated %s: %s: %s", condition.Type, condition.Status, condition.LastTransitionTime.UTC().Format(time.RFC3339), condition.LastUpdateTime.UTC().Format(time.RFC3339), condition.Reason, condition.Message)
+}
+
+// AssertControlPlaneSpread waits until the replicas of every control plane component of the shoot run on distinct seed
+// nodes, i.e. until no two pods controlled by the same owner (e.g. a ReplicaSet or StatefulSet) in the shoot namespace
+// of the seed are co-located on a node. Pods which are not yet scheduled to a node or have no controller are not
+// considered. It returns an error listing the co-located pods if they are not spread within the given timeout.
+func (o *GardenerTestOperation) AssertControlPlaneSpread(ctx context.Context, timeout time.Duration) error {
+	namespace := o.ShootSeedNamespace()
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		podList := &corev1.PodList{}
+		if err := o.SeedClient.Client().List(ctx, podList, client.InNamespace(namespace)); err != nil {
+			return retry.SevereError(fmt.Errorf("could not list pods in namespace %s of seed %s: %v", namespace, o.Seed.Name, err))
+		}
+
+		if colocated := colocatedControlPlanePods(podList.Items); len(colocated) > 0 {
+			o.Logger.Infof("Waiting for the control plane pods in namespace %s to be spread across seed nodes", namespace)
+			return retry.MinorError(fmt.Errorf("control plane pods in namespace %s of seed %s are co-located: %s", namespace, o.Seed.Name, strings.Join(colocated, "; ")))
+		}
+		return retry.Ok()
+	})
+}
+
+// colocatedControlPlanePods returns a description of every group of pods that are controlled by the same owner and run
+// on the same node, sorted for stable output.
+func colocatedControlPlanePods(pods []corev1.Pod) []string {
+	podsByOwnerAndNode := map[string]map[string][]string{}
+
+	for _, pod := range pods {
+		owner := metav1.GetControllerOf(&pod)
+		if owner == nil || len(pod.Spec.NodeName) == 0 {
+			continue
+		}
+
+		ownerKey := fmt.Sprintf("%s %s", owner.Kind, owner.Name)
+		if _, ok := podsByOwnerAndNode[ownerKey]; !ok {
+			podsByOwnerAndNode[ownerKey] = map[string][]string{}
+		}
+		podsByOwnerAndNode[ownerKey][pod.Spec.NodeName] = append(podsByOwnerAndNode[ownerKey][pod.Spec.NodeName], pod.Name)
+	}
+
+	var colocated []string
+	for ownerKey, podsByNode := range podsByOwnerAndNode {
+		for nodeName, podNames := range podsByNode {
+			if len(podNames) > 1 {
+				sort.Strings(podNames)
+				colocated = append(colocated, fmt.Sprintf("pods [%s] of %s run on node %s", strings.Join(podNames, ", "), ownerKey, nodeName))
+			}
+		}
+	}
+	sort.Strings(colocated)
+	return colocated
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// loadBalancerBackendImage is the image of the backend which is exposed by the load balancer in
+// AssertLoadBalancerReachable.
+const loadBalancerBackendImage = "nginx:1.17-alpine"
+
+// AssertAddonVersions waits until the addons in the kube-system namespace of the shoot run the expected versions.
+// The keys of <expected> are the names of the addon deployments or daemon sets and the values are the expected
+// image tags. If the versions do not match within the given timeout, an error listing all mismatches is returned.
+func (o *GardenerTestOperation) AssertAddonVersions(ctx context.Context, expected map[string]string, timeout time.Duration) error {
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		deployed, err := o.getAddonImageTags(ctx)
+		if err != nil {
+			return retry.SevereError(err)
+		}
+
+		if mismatches := addonVersionMismatches(expected, deployed); len(mismatches) > 0 {
+			o.Logger.Infof("Waiting for addons to have the expected versions: %s", strings.Join(mismatches, ", "))
+			return retry.MinorError(fmt.Errorf("addon versions do not match: %s", strings.Join(mismatches, ", ")))
+		}
+
+		return retry.Ok()
+	})
+}
+
+// getAddonImageTags returns the image tags of the containers of all deployments and daemon sets in the kube-system
+// namespace of the shoot, keyed by the name of the deployment or daemon set.
+func (o *GardenerTestOperation) getAddonImageTags(ctx context.Context) (map[string][]string, error) {
+	var (
+		tags        = map[string][]string{}
+		deployments = &appsv1.DeploymentList{}
+		daemonSets  = &appsv1.DaemonSetList{}
+	)
+
+	if err := o.ShootClient.Client().List(ctx, deployments, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		tags[deployment.Name] = imageTags(deployment.Spec.Template.Spec.Containers)
+	}
+
+	if err := o.ShootClient.Client().List(ctx, daemonSets, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, err
+	}
+	for _, daemonSet := range daemonSets.Items {
+		tags[daemonSet.Name] = imageTags(daemonSet.Spec.Template.Spec.Containers)
+	}
+
+	return tags, nil
+}
+
+func addonVersionMismatches(expected map[string]string, deployed map[string][]string) []string {
+	var mismatches []string
+
+	for name, version := range expected {
+		tags, ok := deployed[name]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("%s is not deployed (expected %s)", name, version))
+			continue
+		}
+		if !utils.ValueExists(version, tags) {
+			mismatches = append(mismatches, fmt.Sprintf("%s has version(s) %v (expected %s)", name, tags, version))
+		}
+	}
+
+	sort.Strings(mismatches)
+	return mismatches
+}
+
+// imageTags returns the tags of the images of the given containers.
+func imageTags(containers []corev1.Container) []string {
+	var tags []string
+	for _, container := range containers {
+		tags = append(tags, imageTag(container.Image))
+	}
+	return tags
+}
+
+// imageTag returns the tag of the given image reference or an empty string if it has no tag.
+func imageTag(image string) string {
+	image = strings.SplitN(image, "@", 2)[0]
+	if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+		return image[idx+1:]
+	}
+	return ""
+}
+
+// AssertLoadBalancerReachable creates a test backend and a service of type LoadBalancer with the given name in the
+// given namespace of the shoot. It waits until the load balancer has an external address and the backend can be
+// reached through it. The backend and the service are deleted afterwards.
+func (o *GardenerTestOperation) AssertLoadBalancerReachable(ctx context.Context, namespace, service string, timeout time.Duration) error {
+	var (
+		objectMeta = metav1.ObjectMeta{Namespace: namespace, Name: service}
+		labels     = map[string]string{"app": service}
+		replicas   = int32(1)
+
+		backend = &appsv1.Deployment{
+			ObjectMeta: objectMeta,
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "backend",
+							Image: loadBalancerBackendImage,
+							Ports: []corev1.ContainerPort{{ContainerPort: 80}},
+						}},
+					},
+				},
+			},
+		}
+		loadBalancer = &corev1.Service{
+			ObjectMeta: objectMeta,
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeLoadBalancer,
+				Selector: labels,
+				Ports: []corev1.ServicePort{{
+					Protocol:   corev1.ProtocolTCP,
+					Port:       80,
+					TargetPort: intstr.FromInt(80),
+				}},
+			},
+		}
+	)
+
+	for _, obj := range []runtime.Object{backend, loadBalancer} {
+		if err := o.ShootClient.Client().Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	defer func() {
+		for _, obj := range []runtime.Object{loadBalancer, backend} {
+			if err := o.ShootClient.Client().Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				o.Logger.Errorf("Could not delete %T %s/%s: %v", obj, namespace, service, err)
+			}
+		}
+	}()
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		address, err := kutil.GetLoadBalancerIngress(ctx, o.ShootClient.Client(), namespace, service)
+		if err != nil {
+			o.Logger.Infof("Waiting for load balancer %s/%s to get an external address", namespace, service)
+			return retry.MinorError(err)
+		}
+
+		response, err := o.HTTPGet(ctx, "http://"+address)
+		if err != nil {
+			o.Logger.Infof("Waiting for load balancer %s/%s to be reachable at %s", namespace, service, address)
+			return retry.MinorError(err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return retry.MinorError(fmt.Errorf("load balancer %s/%s at %s returned status %s", namespace, service, address, response.Status))
+		}
+
+		return retry.Ok()
+	})
+}
+
+// AssertLoadBalancerHealthy waits until the load balancer of the given service of the shoot has an external address and
+// all of its backends are healthy. The health of the backends is determined by the LoadBalancerHealthChecker of the
+// operation, or by the ready addresses of the endpoints of the service if none is set. It returns an error if the
+// backends are not healthy within the given timeout.
+func (o *GardenerTestOperation) AssertLoadBalancerHealthy(ctx context.Context, namespace, service string, timeout time.Duration) error {
+	checker := o.LoadBalancerHealthChecker
+	if checker == nil {
+		checker = &endpointsHealthChecker{client: o.ShootClient.Client()}
+	}
+
+	return retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		if _, err := kutil.GetLoadBalancerIngress(ctx, o.ShootClient.Client(), namespace, service); err != nil {
+			o.Logger.Infof("Waiting for load balancer %s/%s to get an external address", namespace, service)
+			return retry.MinorError(err)
+		}
+
+		svc := &corev1.Service{}
+		if err := o.ShootClient.Client().Get(ctx, client.ObjectKey{Namespace: namespace, Name: service}, svc); err != nil {
+			return retry.MinorError(err)
+		}
+
+		healthy, unhealthy, err := checker.BackendHealth(ctx, svc)
+		if err != nil {
+			return retry.MinorError(fmt.Errorf("could not determine the backend health of load balancer %s/%s: %v", namespace, service, err))
+		}
+		if healthy == 0 || unhealthy > 0 {
+			o.Logger.Infof("Waiting for the backends of load balancer %s/%s to be healthy (%d healthy, %d unhealthy)", namespace, service, healthy, unhealthy)
+			return retry.MinorError(fmt.Errorf("load balancer %s/%s has %d healthy and %d unhealthy backends", namespace, service, healthy, unhealthy))
+		}
+
+		o.Logger.Infof("All %d backends of load balancer %s/%s are healthy", healthy, namespace, service)
+		return retry.Ok()
+	})
+}
+
+// endpointsHealthChecker determines the health of the backends of a load balancer by the ready and not ready addresses
+// of the endpoints of its service, which reflect the readiness probes of the backends.
+type endpointsHealthChecker struct {
+	client client.Client
+}
+
+func (c *endpointsHealthChecker) BackendHealth(ctx context.Context, service *corev1.Service) (int, int, error) {
+	endpoints := &corev1.Endpoints{}
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: service.Namespace, Name: service.Name}, endpoints); err != nil {
+		return 0, 0, err
+	}
+
+	var healthy, unhealthy int
+	for _, subset := range endpoints.Subsets {
+		healthy += len(subset.Addresses)
+		unhealthy += len(subset.NotReadyAddresses)
+	}
+	return healthy, unhealthy, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// csiVolumeLifecycleName is the name of the volume claim and its consumer created by the CSI volume lifecycle test.
+	csiVolumeLifecycleName = "csi-volume-lifecycle"
+	// csiVolumeLifecycleMountPath is the path the volume is mounted to in the consumer of the CSI volume lifecycle test.
+	csiVolumeLifecycleMountPath = "/data"
+	// csiVolumeLifecycleData is the data written to and read from the volume by the CSI volume lifecycle test.
+	csiVolumeLifecycleData = "gardener-csi-volume-lifecycle"
+)
+
+// AssertCSIVolumeLifecycle verifies that the CSI driver of the shoot provisions and attaches volumes of the given
+// storage class: it creates a persistent volume claim of the storage class and a pod consuming it, waits until the
+// claim is bound and the pod is ready, and writes data to the volume which must be read back unchanged. Each step must
+// succeed within the given timeout. The created objects are deleted afterwards.
+func (o *GardenerTestOperation) AssertCSIVolumeLifecycle(ctx context.Context, storageClass string, timeout time.Duration) (err error) {
+	var (
+		pod         = newConformanceSmokePod(csiVolumeLifecycleName)
+		volumeClaim = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: csiVolumeLifecycleName},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: &storageClass,
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+		file = csiVolumeLifecycleMountPath + "/" + csiVolumeLifecycleName
+	)
+
+	pod.Spec.Volumes = []corev1.Volume{{
+		Name: "data",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: volumeClaim.Name},
+		},
+	}}
+	pod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "data", MountPath: csiVolumeLifecycleMountPath}}
+
+	defer func() {
+		for _, obj := range []runtime.Object{pod, volumeClaim} {
+			if deleteErr := o.ShootClient.Client().Delete(ctx, obj); deleteErr != nil && !apierrors.IsNotFound(deleteErr) && err == nil {
+				err = fmt.Errorf("could not delete %T of the CSI volume lifecycle test: %v", obj, deleteErr)
+			}
+		}
+	}()
+
+	if err := o.checkConformanceSmokeVolumeClaimBinding(ctx, volumeClaim, pod, timeout); err != nil {
+		return fmt.Errorf("volume of storage class %s was not provisioned: %v", storageClass, err)
+	}
+	if err := o.checkConformanceSmokePodScheduling(ctx, pod, timeout); err != nil {
+		return fmt.Errorf("volume of storage class %s was not attached: %v", storageClass, err)
+	}
+
+	executor := o.PodExecutor
+	if executor == nil {
+		executor = kubernetes.NewPodExecutor(o.ShootClient.RESTConfig())
+	}
+
+	if err := retry.UntilTimeout(ctx, defaultPollInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		if _, err := executor.Execute(ctx, pod.Namespace, pod.Name, conformanceSmokeContainer, fmt.Sprintf("echo %s > %s && sync", csiVolumeLifecycleData, file)); err != nil {
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return fmt.Errorf("could not write to volume of storage class %s in pod %s/%s: %v", storageClass, pod.Namespace, pod.Name, err)
+	}
+
+	reader, err := executor.Execute(ctx, pod.Namespace, pod.Name, conformanceSmokeContainer, "cat "+file)
+	if err != nil {
+		return fmt.Errorf("could not read from volume of storage class %s in pod %s/%s: %v", storageClass, pod.Namespace, pod.Name, err)
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if read := strings.TrimSpace(string(data)); read != csiVolumeLifecycleData {
+		return fmt.Errorf("volume of storage class %s returned %q instead of the written data %q", storageClass, read, csiVolumeLifecycleData)
+	}
+
+	o.Logger.Infof("Volume of storage class %s was provisioned, attached, written and read in shoot %s", storageClass, o.Shoot.Name)
+	return nil
+}
@@ -15,6 +15,7 @@
 package framework
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 
@@ -25,6 +26,8 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 // Helm is the home for the HELM repo
@@ -110,8 +113,36 @@ type GardenerTestOperation struct {
 	SeedCloudProfile *gardenv1beta1.CloudProfile
 	Shoot            *gardenv1beta1.Shoot
 	Project          *gardenv1beta1.Project
+
+	// DNSResolver is used to resolve the DNS records of the shoot. If not set, the default resolver is used.
+	DNSResolver DNSResolver
+	// LoadBalancerHealthChecker is used to determine the health of the backends of load balancers of the shoot. If not
+	// set, the health is determined by the endpoints of the load balancer services.
+	LoadBalancerHealthChecker LoadBalancerHealthChecker
+	// PodExecutor is used to execute commands in the pods of the seed and the shoot. If not set, the commands are
+	// executed with the REST config of the seed or shoot client, respectively.
+	PodExecutor kubernetes.PodExecutor
+	// OIDCTokenVerifier is used to verify that a relying party accepts the tokens issued for the service accounts of the
+	// shoot. If not set, the tokens are verified against the discovery document and keys published by their issuer.
+	OIDCTokenVerifier OIDCTokenVerifier
+}
+
+// DNSResolver resolves DNS records, it is implemented by *net.Resolver.
+type DNSResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupCNAME(ctx context.Context, host string) (string, error)
 }
 
+// LoadBalancerHealthChecker determines the health of the backends of a load balancer, e.g. by querying the health
+// checks of the cloud provider.
+type LoadBalancerHealthChecker interface {
+	// BackendHealth returns the number of healthy and unhealthy backends of the load balancer of the given service.
+	BackendHealth(ctx context.Context, service *corev1.Service) (healthy, unhealthy int, err error)
+}
+
+// OIDCTokenVerifier verifies that a relying party federating with the given issuer accepts the given token.
+type OIDCTokenVerifier func(ctx context.Context, issuer, token string) error
+
 // HelmAccess is a struct that holds the helm home
 type HelmAccess struct {
 	HelmPath Helm